@@ -0,0 +1,178 @@
+package main
+
+import (
+	"fmt"
+
+	pgs "github.com/lyft/protoc-gen-star"
+	pbt "go.appointy.com/protoc-gen-jaal/schema"
+)
+
+type Connection struct {
+	Name         string
+	EdgeName     string
+	TypeName     string
+	EdgeTypeName string
+	NodeType     string
+	NodeTypeName string
+	PageSize     string
+	PageSizeType string
+	PageSizeRef  bool
+	PageToken    string
+	PageTokenRef bool
+	Items        string
+	NextToken    string
+}
+
+func (m *jaalModule) connectionField(message pgs.Message, name string, fallback string) (pgs.Field, error) {
+	// returns the field named by a connection option, fallback is used for an empty option
+
+	if name == "" {
+		name = fallback
+	}
+
+	for _, field := range message.Fields() {
+		if field.Name().String() == name {
+			return field, nil
+		}
+	}
+
+	return nil, fmt.Errorf("%s: field %s not found", message.FullyQualifiedName(), name)
+}
+
+func (m *jaalModule) connectionItems(message pgs.Message, name string) (pgs.Field, error) {
+	// returns the repeated field holding the nodes of a connection
+
+	if name != "" {
+		field, err := m.connectionField(message, name, "")
+		if err != nil {
+			return nil, err
+		}
+
+		if !field.Type().IsRepeated() || !field.Type().Element().IsEmbed() {
+			return nil, fmt.Errorf("%s: connection items %s must be a repeated message", message.FullyQualifiedName(), name)
+		}
+
+		return field, nil
+	}
+
+	var items pgs.Field
+	for _, field := range message.Fields() {
+		if !field.Type().IsRepeated() || !field.Type().Element().IsEmbed() {
+			continue
+		}
+
+		if items != nil {
+			return nil, fmt.Errorf("%s: connection items must be set, more than one repeated message found", message.FullyQualifiedName())
+		}
+		items = field
+	}
+
+	if items == nil {
+		return nil, fmt.Errorf("%s: connection items must be a repeated message", message.FullyQualifiedName())
+	}
+
+	return items, nil
+}
+
+func (m *jaalModule) GetConnection(rpc pgs.Method, option pbt.MethodOptions) (*Connection, error) {
+	// returns the connection returned by a list query, nil if rpc is not tagged as connection
+
+	opt := option.GetConnection()
+	if opt == nil {
+		return nil, nil
+	}
+
+	if option.GetQuery() == "" {
+		return nil, fmt.Errorf("%s: connection can be used with queries only", rpc.FullyQualifiedName())
+	}
+
+	pageSize, err := m.connectionField(rpc.Input(), opt.GetPageSize(), "page_size")
+	if err != nil {
+		return nil, err
+	}
+
+	if !pageSize.Type().ProtoType().IsInt() || pageSize.Type().IsRepeated() {
+		return nil, fmt.Errorf("%s: connection page_size %s must be an integer", rpc.FullyQualifiedName(), pageSize.Name())
+	}
+
+	pageToken, err := m.connectionField(rpc.Input(), opt.GetPageToken(), "page_token")
+	if err != nil {
+		return nil, err
+	}
+
+	nextToken, err := m.connectionField(rpc.Output(), opt.GetNextToken(), "next_page_token")
+	if err != nil {
+		return nil, err
+	}
+
+	for _, field := range []pgs.Field{pageToken, nextToken} {
+		if field.Type().ProtoType() != pgs.StringT || field.Type().IsRepeated() || (field == nextToken && m.IsProto3Optional(field)) {
+			return nil, fmt.Errorf("%s: connection token %s must be a string", rpc.FullyQualifiedName(), field.Name())
+		}
+	}
+
+	items, err := m.connectionItems(rpc.Output(), opt.GetItems())
+	if err != nil {
+		return nil, err
+	}
+
+	node := items.Type().Element().Embed()
+	if m.GetGoPackage(node.File()) != m.GetGoPackage(rpc.File()) {
+		return nil, fmt.Errorf("%s: connection items %s must be declared in the package of the rpc", rpc.FullyQualifiedName(), items.Name())
+	}
+
+	nodeTypeName, err := m.PayloadObjectName(node)
+	if err != nil {
+		return nil, err
+	}
+
+	nodeType := m.Context.Name(node).String()
+
+	return &Connection{
+		Name:         nodeType + "Connection",
+		EdgeName:     nodeType + "Edge",
+		TypeName:     nodeTypeName + "Connection",
+		EdgeTypeName: nodeTypeName + "Edge",
+		NodeType:     nodeType,
+		NodeTypeName: nodeTypeName,
+		PageSize:     pageSize.Name().UpperCamelCase().String(),
+		PageSizeType: m.RPCFieldType(pageSize),
+		PageSizeRef:  m.IsPresenceField(pageSize),
+		PageToken:    pageToken.Name().UpperCamelCase().String(),
+		PageTokenRef: m.IsPresenceField(pageToken),
+		Items:        items.Name().UpperCamelCase().String(),
+		NextToken:    nextToken.Name().UpperCamelCase().String(),
+	}, nil
+}
+
+func (m *jaalModule) PackageConnections(files []pgs.File) ([]Connection, error) {
+	// returns the connections returned by the queries of the files of a package, one per node type
+
+	var connections []Connection
+	added := make(map[string]bool)
+
+	for _, file := range files {
+		for _, service := range file.Services() {
+			for _, rpc := range service.Methods() {
+				flag, option, err := m.GetOption(rpc)
+				if err != nil {
+					return nil, err
+				} else if !flag {
+					continue
+				}
+
+				connection, err := m.GetConnection(rpc, option)
+				if err != nil {
+					return nil, err
+				} else if connection == nil || added[connection.Name] {
+					continue
+				}
+
+				added[connection.Name] = true
+				connections = append(connections, *connection)
+			}
+		}
+	}
+
+	return connections, nil
+}
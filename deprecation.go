@@ -0,0 +1,66 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/golang/protobuf/proto"
+	pgs "github.com/lyft/protoc-gen-star"
+	pbt "go.appointy.com/protoc-gen-jaal/schema"
+)
+
+// defaultDeprecationReason is the reason of an element deprecated without one, as defined by the GraphQL spec.
+const defaultDeprecationReason = "No longer supported"
+
+func (m *jaalModule) GetDeprecationReasonOption(field pgs.Field) (string, error) {
+	//returns deprecation_reason option for a message field
+
+	opt := field.Descriptor().GetOptions()
+	if opt == nil {
+		return "", nil
+	}
+
+	x, err := proto.GetExtension(opt, pbt.E_DeprecationReason)
+	if err != nil {
+		if err == proto.ErrMissingExtension {
+			return "", nil
+		}
+		return "", fmt.Errorf("%s: %v", field.FullyQualifiedName(), err)
+	}
+
+	return *x.(*string), nil
+}
+
+func (m *jaalModule) Deprecation(entity pgs.Entity) (string, error) {
+	/*
+		returns the reason of the deprecation of a field, an rpc or an enum value, empty if it is not deprecated
+		an entity is deprecated by the deprecated option of proto or by a deprecation reason, the reason defaults to the one of GraphQL
+	*/
+
+	deprecated := false
+	reason := ""
+	switch e := entity.(type) {
+	case pgs.Field:
+		deprecated = e.Descriptor().GetOptions().GetDeprecated()
+		option, err := m.GetDeprecationReasonOption(e)
+		if err != nil {
+			return "", err
+		}
+		reason = option
+	case pgs.Method:
+		deprecated = e.Descriptor().GetOptions().GetDeprecated()
+		_, option, err := m.GetOption(e)
+		if err != nil {
+			return "", err
+		}
+		reason = option.GetDeprecationReason()
+	case pgs.EnumValue:
+		deprecated = e.Descriptor().GetOptions().GetDeprecated()
+	}
+
+	if reason != "" {
+		return reason, nil
+	} else if deprecated {
+		return defaultDeprecationReason, nil
+	}
+	return "", nil
+}
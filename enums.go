@@ -0,0 +1,182 @@
+package main
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/golang/protobuf/proto"
+	pgs "github.com/lyft/protoc-gen-star"
+	pbt "go.appointy.com/protoc-gen-jaal/schema"
+)
+
+// enumValueNameRegexp matches the names allowed for enum values by the GraphQL spec, true, false and null excluded.
+var enumValueNameRegexp = regexp.MustCompile(`^[_A-Za-z][_0-9A-Za-z]*$`)
+
+func (m *jaalModule) GetValueNameOption(value pgs.EnumValue) (string, error) {
+	//returns value_name option of an enum value, empty if the value is not renamed
+
+	opt := value.Descriptor().GetOptions()
+	if opt == nil {
+		return "", nil
+	}
+
+	x, err := proto.GetExtension(opt, pbt.E_ValueName)
+	if err != nil {
+		if err == proto.ErrMissingExtension {
+			return "", nil
+		}
+		return "", fmt.Errorf("%s: %v", value.FullyQualifiedName(), err)
+	}
+
+	return *x.(*string), nil
+}
+
+func (m *jaalModule) GetValueSkipOption(value pgs.EnumValue) (bool, error) {
+	//returns value_skip option of an enum value
+
+	opt := value.Descriptor().GetOptions()
+	if opt == nil {
+		return false, nil
+	}
+
+	x, err := proto.GetExtension(opt, pbt.E_ValueSkip)
+	if err != nil {
+		if err == proto.ErrMissingExtension {
+			return false, nil
+		}
+		return false, fmt.Errorf("%s: %v", value.FullyQualifiedName(), err)
+	}
+
+	return *x.(*bool), nil
+}
+
+func (m *jaalModule) StripEnumPrefix(file pgs.File) (bool, error) {
+	// returns true if the prefix of the enums of a file is stripped from their values, the file option overrides the parameter
+
+	if opt := file.Descriptor().GetOptions(); opt != nil {
+		x, err := proto.GetExtension(opt, pbt.E_StripEnumPrefix)
+		if err == nil {
+			return *x.(*bool), nil
+		} else if err != proto.ErrMissingExtension {
+			return false, fmt.Errorf("%s: %v", file.Name(), err)
+		}
+	}
+
+	return m.Parameters().Bool("strip_enum_prefix")
+}
+
+func (m *jaalModule) EnumTypeName(enum pgs.Enum) string {
+	// returns name of the enum registered on graphql schema, jaal names an enum after its go type, e.g. Order_Status for Order.Status
+
+	return m.Context.Name(enum).String()
+}
+
+func (m *jaalModule) EnumValueName(value pgs.EnumValue) (string, error) {
+	/*
+		returns name of an enum value on graphql schema, the value_name option or the proto name of the value
+		with strip_enum_prefix, the name of the enum in screaming snake case is stripped from the proto name, e.g. PENDING for ORDER_STATUS_PENDING of OrderStatus
+		a value whose name would not be a graphql name without the prefix keeps it
+	*/
+
+	newName, err := m.GetValueNameOption(value)
+	if err != nil {
+		return "", err
+	} else if newName != "" {
+		if !enumValueNameRegexp.MatchString(newName) || newName == "true" || newName == "false" || newName == "null" {
+			return "", fmt.Errorf("%s: value_name %s is not a valid GraphQL enum value", value.FullyQualifiedName(), newName)
+		}
+		return newName, nil
+	}
+
+	name := value.Name().String()
+	strip, err := m.StripEnumPrefix(value.File())
+	if err != nil || !strip {
+		return name, err
+	}
+
+	prefix := value.Enum().Name().ScreamingSnakeCase().String() + "_"
+	if stripped := strings.TrimPrefix(name, prefix); stripped != name && enumValueNameRegexp.MatchString(stripped) {
+		return stripped, nil
+	}
+
+	return name, nil
+}
+
+func (m *jaalModule) IsHiddenValue(value pgs.EnumValue) (bool, error) {
+	// returns true if an enum value is not registered on graphql schema, i.e. it is skipped or it is a zero value with the skip_enum_zero parameter
+
+	skip, err := m.GetValueSkipOption(value)
+	if err != nil || skip {
+		return skip, err
+	}
+
+	if value.Value() != 0 {
+		return false, nil
+	}
+	return m.Parameters().Bool("skip_enum_zero")
+}
+
+func (m *jaalModule) HiddenValues(enum pgs.Enum) ([]int32, error) {
+	// returns the numbers of an enum which are exposed as null on the payload, the numbers of which every alias is hidden
+
+	visible := make(map[int32]bool)
+	seen := make(map[int32]bool)
+	var numbers []int32
+	for _, value := range enum.Values() {
+		hidden, err := m.IsHiddenValue(value)
+		if err != nil {
+			return nil, err
+		} else if !hidden {
+			visible[value.Value()] = true
+		} else if !seen[value.Value()] {
+			seen[value.Value()] = true
+			numbers = append(numbers, value.Value())
+		}
+	}
+
+	hidden := numbers[:0]
+	for _, number := range numbers {
+		if !visible[number] {
+			hidden = append(hidden, number)
+		}
+	}
+
+	return hidden, nil
+}
+
+func (m *jaalModule) HidesZero(field pgs.Field) (bool, error) {
+	// returns true if field is a singular enum whose zero value is hidden, the field is then accepted as nullable and null is the zero value
+
+	if !field.Type().IsEnum() || m.IsPresenceField(field) || m.InOneOf(field) {
+		return false, nil
+	}
+
+	hidden, err := m.HiddenValues(field.Type().Enum())
+	if err != nil {
+		return false, err
+	}
+	for _, number := range hidden {
+		if number == 0 {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+func (m *jaalModule) hiddenValueConds(enum pgs.Enum, value string) ([]string, error) {
+	// returns the go conditions true when value is a hidden number of enum, one per number
+
+	numbers, err := m.HiddenValues(enum)
+	if err != nil {
+		return nil, err
+	}
+
+	conds := make([]string, 0, len(numbers))
+	for _, number := range numbers {
+		conds = append(conds, fmt.Sprintf("%s == %d", value, number))
+	}
+
+	return conds, nil
+}
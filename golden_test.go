@@ -0,0 +1,735 @@
+package main
+
+import (
+	"bytes"
+	"flag"
+	"go/ast"
+	"go/importer"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/protoc-gen-go/descriptor"
+	"github.com/golang/protobuf/protoc-gen-go/generator"
+	_ "github.com/golang/protobuf/protoc-gen-go/grpc"
+	plugin "github.com/golang/protobuf/protoc-gen-go/plugin"
+	pgs "github.com/lyft/protoc-gen-star"
+	pgsgo "github.com/lyft/protoc-gen-star/lang/go"
+	pbt "go.appointy.com/protoc-gen-jaal/schema"
+)
+
+/*
+	The golden tests run the module on the fixtures of testdata. Each fixture is a directory holding the proto files,
+	the FileDescriptorSet built from them and the golden files of the generated code. After changing a fixture, rebuild
+	its descriptor set from the root of the repository:
+
+		protoc -I testdata/customer -I . --include_imports --include_source_info -o testdata/customer/fdset.bin testdata/customer/*.proto
+
+	The generated code of a fixture is type-checked with the output of protoc-gen-go of golang/protobuf v1.3.1. The
+	well-known types of its set keep the go_package of the ptypes packages, as shipped with protoc 3.13 and older, or
+	have the go_package of google.golang.org/protobuf, whose packages are stubbed in testdata/stubs as for events.
+
+	After changing the generated code, review the diff and update the golden files:
+
+		go test -run TestGolden -update
+*/
+
+var update = flag.Bool("update", false, "update the golden files of testdata")
+
+// fixtures are the directories of testdata the module is run on, along with the plugin parameters.
+var fixtures = []struct {
+	name   string
+	params string
+	// typecheck type-checks the generated code together with the output of protoc-gen-go
+	typecheck bool
+	// pbGo type-checks the generated code together with the .pb.go files of the fixture instead
+	pbGo bool
+}{
+	{name: "customer", params: "sdl=true,operations=true", typecheck: true},
+	{name: "entries", params: "sdl=true,maps=entries,any=union,init=false,operations=true,operations_depth=2", typecheck: true},
+	// validate/validate.proto is a trimmed copy of the options of protoc-gen-validate
+	{name: "validate", params: "sdl=true", typecheck: true},
+	{name: "enums", params: "sdl=true,skip_enum_zero=true", typecheck: true},
+	// the well-known types of events have the go packages of google.golang.org/protobuf, its imported enum a versioned path
+	{name: "events", params: "sdl=true", typecheck: true},
+	// the protoc-gen-go of golang/protobuf v1.3.1 predates proto3 optional, the output of google.golang.org/protobuf is checked in
+	{name: "optional", params: "", typecheck: true, pbGo: true},
+}
+
+func TestGolden(t *testing.T) {
+	for _, fixture := range fixtures {
+		fixture := fixture
+		t.Run(fixture.name, func(t *testing.T) {
+			dir := filepath.Join("testdata", fixture.name)
+			fdset, targets := loadFixture(t, dir)
+
+			generated := generate(t, fdset, targets, fixture.params)
+			if *update {
+				writeGolden(t, filepath.Join(dir, "golden"), generated)
+			}
+
+			golden := readGolden(t, filepath.Join(dir, "golden"))
+			for name, content := range generated {
+				want, ok := golden[name]
+				if !ok {
+					t.Errorf("%s: unexpected file, run go test -update", name)
+					continue
+				}
+				if content != want {
+					t.Errorf("%s: generated code differs from the golden file, run go test -update and review the diff", name)
+				}
+			}
+			for name := range golden {
+				if _, ok := generated[name]; !ok {
+					t.Errorf("%s: file is not generated anymore, run go test -update", name)
+				}
+			}
+
+			checkSDL(t, fdset, targets, generated)
+			if fixture.typecheck {
+				pb := protocGenGo(t, fdset, targets)
+				if fixture.pbGo {
+					pb = readPBGo(t, dir)
+				}
+				typecheck(t, generated, pb)
+			}
+		})
+	}
+}
+
+func TestValidate(t *testing.T) {
+	// a field_name used by a sibling field is reported instead of generating the file
+
+	fdset, targets := loadFixture(t, filepath.Join("testdata", "customer"))
+	for _, file := range fdset.File {
+		if file.GetName() != "customer.proto" {
+			continue
+		}
+		for _, message := range file.MessageType {
+			if message.GetName() != "Customer" {
+				continue
+			}
+			for _, field := range message.Field {
+				if field.GetName() != "first_name" {
+					continue
+				}
+				field.Options = &descriptor.FieldOptions{}
+				if err := proto.SetExtension(field.Options, pbt.E_FieldName, proto.String("email")); err != nil {
+					t.Fatal(err)
+				}
+			}
+		}
+	}
+
+	d := pgs.InitMockDebugger()
+	runModule(d, fdset, targets, "")
+	if !d.Failed() {
+		t.Fatal("expected the duplicate field name to fail the generation")
+	}
+
+	output, _ := ioutil.ReadAll(d.Output())
+	if want := "customer.proto: .customer.Customer.first_name: field email is already used by .customer.Customer.email"; !strings.Contains(string(output), want) {
+		t.Errorf("expected %q, got %s", want, output)
+	}
+}
+
+func TestValidateGenerated(t *testing.T) {
+	// a message named after a generated type is reported instead of generating the file
+
+	fdset, targets := loadFixture(t, filepath.Join("testdata", "validate"))
+	for _, file := range fdset.File {
+		if file.GetName() == "account.proto" {
+			file.MessageType = append(file.MessageType, &descriptor.DescriptorProto{Name: proto.String("AccountConnection")})
+		}
+	}
+
+	d := pgs.InitMockDebugger()
+	runModule(d, fdset, targets, "")
+	if !d.Failed() {
+		t.Fatal("expected the generated type name to fail the generation")
+	}
+
+	output, _ := ioutil.ReadAll(d.Output())
+	for _, want := range []string{
+		"account.proto: .account.AccountConnection: type AccountConnection is already generated for the connections of Account",
+		"account.proto: .account.AccountConnection: go type AccountConnection is already generated for the connections of Account",
+	} {
+		if !strings.Contains(string(output), want) {
+			t.Errorf("expected %q, got %s", want, output)
+		}
+	}
+}
+
+func TestValidateNodeQuery(t *testing.T) {
+	// an rpc using a query generated for the nodes of its package is reported instead of generating the file
+
+	fdset, targets := loadFixture(t, filepath.Join("testdata", "customer"))
+	for _, file := range fdset.File {
+		if file.GetName() != "customer.proto" {
+			continue
+		}
+		for _, method := range file.Service[0].Method {
+			if method.GetName() != "FindCustomer" {
+				continue
+			}
+			method.Options = &descriptor.MethodOptions{}
+			if err := proto.SetExtension(method.Options, pbt.E_Schema, &pbt.MethodOptions{Type: &pbt.MethodOptions_Query{Query: "nodes"}}); err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+
+	d := pgs.InitMockDebugger()
+	runModule(d, fdset, targets, "")
+	if !d.Failed() {
+		t.Fatal("expected the node query to fail the generation")
+	}
+
+	output, _ := ioutil.ReadAll(d.Output())
+	if want := "customer.proto: .customer.Customers.FindCustomer: query nodes is already generated for the nodes of package customerpb"; !strings.Contains(string(output), want) {
+		t.Errorf("expected %q, got %s", want, output)
+	}
+}
+
+func TestValidateHiddenValue(t *testing.T) {
+	// a hidden enum value is not registered, so its name may be used by another value
+
+	fdset, targets := loadFixture(t, filepath.Join("testdata", "enums"))
+	for _, file := range fdset.File {
+		if file.GetName() != "order.proto" {
+			continue
+		}
+		for _, message := range file.MessageType {
+			if message.GetName() != "Shipment" {
+				continue
+			}
+			for _, value := range message.EnumType[0].Value {
+				if value.GetName() != "LOST" {
+					continue
+				}
+				if err := proto.SetExtension(value.Options, pbt.E_ValueName, proto.String("DELIVERED")); err != nil {
+					t.Fatal(err)
+				}
+			}
+		}
+	}
+
+	d := pgs.InitMockDebugger()
+	runModule(d, fdset, targets, "sdl=true,skip_enum_zero=true")
+	if d.Failed() {
+		output, _ := ioutil.ReadAll(d.Output())
+		t.Errorf("expected the hidden value to be left out of the validation, got %s", output)
+	}
+}
+
+func TestValidatePackage(t *testing.T) {
+	// a file generated without the other files of its package, or named after the files of the package, is reported
+
+	fdset, targets := loadFixture(t, filepath.Join("testdata", "customer"))
+	for _, file := range fdset.File {
+		if file.GetName() == "customer.proto" {
+			fdset.File = append(fdset.File, &descriptor.FileDescriptorProto{Name: proto.String("address.proto"), Package: file.Package, Options: file.Options, Syntax: file.Syntax})
+			file.Name = proto.String("jaal.proto")
+		}
+	}
+	for i, target := range targets {
+		if target == "customer.proto" {
+			targets[i] = "jaal.proto"
+		}
+	}
+
+	d := pgs.InitMockDebugger()
+	runModule(d, fdset, targets, "")
+	if !d.Failed() {
+		t.Fatal("expected the partial package to fail the generation")
+	}
+
+	output, _ := ioutil.ReadAll(d.Output())
+	for _, want := range []string{
+		"jaal.proto: the files of the package are generated as jaal.pb.gq.go and jaal.graphql, the file must be renamed",
+		"jaal.proto: address.proto of package customer must be generated along with it",
+	} {
+		if !strings.Contains(string(output), want) {
+			t.Errorf("expected %q, got %s", want, output)
+		}
+	}
+}
+
+func TestValidateMajorVersion(t *testing.T) {
+	// a go package ending with a major version is named by protoc-gen-go after the version, its name must be given
+
+	fdset, targets := loadFixture(t, filepath.Join("testdata", "customer"))
+	for _, file := range fdset.File {
+		if file.GetName() == "customer.proto" {
+			file.Options.GoPackage = proto.String("example.com/customer/v2")
+		}
+	}
+
+	d := pgs.InitMockDebugger()
+	runModule(d, fdset, targets, "")
+	if !d.Failed() {
+		t.Fatal("expected the go package without name to fail the generation")
+	}
+
+	output, _ := ioutil.ReadAll(d.Output())
+	want := "customer.proto: go_package example.com/customer/v2 ends with a major version, its package name must be given after ;, e.g. example.com/customer/v2;customer"
+	if !strings.Contains(string(output), want) {
+		t.Errorf("expected %q, got %s", want, output)
+	}
+}
+
+func TestSupportedFeatures(t *testing.T) {
+	// the response written by the plugin declares proto3 optional, along with the generated files
+
+	fdset, targets := loadFixture(t, filepath.Join("testdata", "optional"))
+	request, err := proto.Marshal(&plugin.CodeGeneratorRequest{FileToGenerate: targets, ProtoFile: fdset.File})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	output := &bytes.Buffer{}
+	newGenerator(bytes.NewReader(request), output).Render()
+
+	response := &plugin.CodeGeneratorResponse{}
+	if err := proto.Unmarshal(output.Bytes(), response); err != nil {
+		t.Fatal(err)
+	}
+	if response.Error != nil {
+		t.Fatalf("unexpected error %s", response.GetError())
+	}
+	if len(response.File) == 0 {
+		t.Error("expected the generated files in the response")
+	}
+
+	// supported_features (2) is unknown to the CodeGeneratorResponse of golang/protobuf v1.3.1
+	features := proto.NewBuffer(nil)
+	features.EncodeVarint(2<<3 | proto.WireVarint)
+	features.EncodeVarint(featureProto3Optional)
+	if !bytes.Equal(response.XXX_unrecognized, features.Bytes()) {
+		t.Errorf("expected supported_features %x, got %x", features.Bytes(), response.XXX_unrecognized)
+	}
+}
+
+func TestLoaderHeaders(t *testing.T) {
+	/*
+		runs testdata/customer/run_test.go on the generated code of the fixture, the batch calls of its loaders are
+		checked with the headers of the request
+		the jaal packages are not modules, the code is built in a GOPATH holding the stubs of testdata/stubs
+	*/
+
+	if testing.Short() {
+		t.Skip("builds the generated code")
+	}
+
+	fdset, targets := loadFixture(t, filepath.Join("testdata", "customer"))
+	gopath, err := ioutil.TempDir("", "jaal")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(gopath)
+
+	dir := filepath.Join(gopath, "src", "example.com", "customerpb")
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		t.Fatal(err)
+	}
+	files := protocGenGo(t, fdset, targets)
+	for name, content := range generate(t, fdset, targets, "sdl=true,operations=true") {
+		files[name] = content
+	}
+	run, err := ioutil.ReadFile(filepath.Join("testdata", "customer", "run_test.go"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	files["run_test.go"] = string(run)
+	for name, content := range files {
+		if strings.HasSuffix(name, ".go") {
+			if err := ioutil.WriteFile(filepath.Join(dir, name), []byte(content), 0644); err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+
+	// the repositories of the stubs and golang/protobuf are linked in the GOPATH
+	repositories, err := filepath.Glob(filepath.Join("testdata", "stubs", "*", "*"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	protobuf, err := exec.Command("go", "list", "-m", "-f", "{{.Dir}}", "github.com/golang/protobuf").Output()
+	if err != nil {
+		t.Fatal(err)
+	}
+	links := map[string]string{filepath.Join("github.com", "golang", "protobuf"): strings.TrimSpace(string(protobuf))}
+	for _, repository := range repositories {
+		links[strings.TrimPrefix(repository, filepath.Join("testdata", "stubs")+string(filepath.Separator))] = repository
+	}
+	for link, target := range links {
+		target, err := filepath.Abs(target)
+		if err != nil {
+			t.Fatal(err)
+		}
+		link = filepath.Join(gopath, "src", link)
+		if err := os.MkdirAll(filepath.Dir(link), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.Symlink(target, link); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	cmd := exec.Command("go", "test", ".")
+	cmd.Dir = dir
+	cmd.Env = append(os.Environ(), "GO111MODULE=off", "GOPATH="+gopath, "GOFLAGS=")
+	if output, err := cmd.CombinedOutput(); err != nil {
+		t.Fatalf("%v: %s", err, output)
+	}
+}
+
+func loadFixture(t *testing.T, dir string) (*descriptor.FileDescriptorSet, []string) {
+	// returns the descriptor set of a fixture and the names of its proto files, the other files of the set are imports
+
+	data, err := ioutil.ReadFile(filepath.Join(dir, "fdset.bin"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	fdset := &descriptor.FileDescriptorSet{}
+	if err := proto.Unmarshal(data, fdset); err != nil {
+		t.Fatal(err)
+	}
+
+	protos, err := filepath.Glob(filepath.Join(dir, "*.proto"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var targets []string
+	for _, name := range protos {
+		targets = append(targets, filepath.Base(name))
+	}
+
+	return fdset, targets
+}
+
+func runModule(d pgs.MockDebugger, fdset *descriptor.FileDescriptorSet, targets []string, params string) []pgs.Artifact {
+	// runs the module on targets, the files of the set are processed as by protoc
+
+	ast := pgs.ProcessFileDescriptorSet(d, fdset)
+
+	files := make(map[string]pgs.File)
+	for _, pkg := range ast.Packages() {
+		for _, file := range pkg.Files() {
+			files[file.Name().String()] = file
+		}
+	}
+
+	targetFiles := make(map[string]pgs.File)
+	for _, name := range targets {
+		targetFiles[name] = files[name]
+	}
+
+	m := &jaalModule{ModuleBase: &pgs.ModuleBase{}}
+	m.InitContext(pgs.Context(d, pgs.ParseParameters(params), "."))
+
+	return m.Execute(targetFiles, ast.Packages())
+}
+
+func generate(t *testing.T, fdset *descriptor.FileDescriptorSet, targets []string, params string) map[string]string {
+	// returns the files generated by the module, formatted as protoc-gen-jaal does
+
+	d := pgs.InitMockDebugger()
+	artifacts := runModule(d, fdset, targets, params)
+	if d.Failed() {
+		output, _ := ioutil.ReadAll(d.Output())
+		t.Fatalf("generation failed: %s", output)
+	}
+
+	gofmt := pgsgo.GoFmt()
+	generated := make(map[string]string)
+	for _, artifact := range artifacts {
+		file, ok := artifact.(pgs.GeneratorFile)
+		if !ok {
+			t.Fatalf("unexpected artifact %T", artifact)
+		}
+
+		content := []byte(file.Contents)
+		if gofmt.Match(artifact) {
+			var err error
+			if content, err = gofmt.Process(content); err != nil {
+				t.Fatalf("%s: %v", file.Name, err)
+			}
+		}
+		generated[path.Clean(file.Name)] = string(content)
+	}
+
+	return generated
+}
+
+func readGolden(t *testing.T, dir string) map[string]string {
+	golden := make(map[string]string)
+	err := filepath.Walk(dir, func(name string, info os.FileInfo, err error) error {
+		if err != nil || info.IsDir() {
+			return err
+		}
+
+		data, err := ioutil.ReadFile(name)
+		if err != nil {
+			return err
+		}
+
+		rel, err := filepath.Rel(dir, name)
+		if err != nil {
+			return err
+		}
+		golden[filepath.ToSlash(rel)] = string(data)
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return golden
+}
+
+func writeGolden(t *testing.T, dir string, generated map[string]string) {
+	if err := os.RemoveAll(dir); err != nil {
+		t.Fatal(err)
+	}
+
+	for name, content := range generated {
+		name = filepath.Join(dir, filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(name), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := ioutil.WriteFile(name, []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func protocGenGo(t *testing.T, fdset *descriptor.FileDescriptorSet, targets []string) map[string]string {
+	// returns the files generated by protoc-gen-go, with the grpc plugin, for targets
+
+	g := generator.New()
+	g.Request = &plugin.CodeGeneratorRequest{FileToGenerate: targets, ProtoFile: fdset.File}
+	g.CommandLineParameters("plugins=grpc,paths=source_relative")
+	g.WrapTypes()
+	g.SetPackageNames()
+	g.BuildTypeNameMap()
+	g.GenerateAllFiles()
+
+	generated := make(map[string]string)
+	for _, file := range g.Response.File {
+		generated[file.GetName()] = file.GetContent()
+	}
+	if g.Response.Error != nil {
+		t.Fatalf("protoc-gen-go: %s", g.Response.GetError())
+	}
+
+	return generated
+}
+
+func readPBGo(t *testing.T, dir string) map[string]string {
+	// returns the .pb.go files of a fixture, named as the output of protoc-gen-go
+
+	names, err := filepath.Glob(filepath.Join(dir, "*.pb.go"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	files := make(map[string]string)
+	for _, name := range names {
+		content, err := ioutil.ReadFile(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		files[filepath.Base(name)] = string(content)
+	}
+
+	return files
+}
+
+func typecheck(t *testing.T, generated, pb map[string]string) {
+	/*
+		type-checks the generated go files of each directory along with the output of protoc-gen-go
+		the jaal packages, grpc and genproto are replaced by the stubs of testdata/stubs, others are imported from source
+	*/
+
+	packages := make(map[string]map[string]string)
+	for _, files := range []map[string]string{generated, pb} {
+		for name, content := range files {
+			if !strings.HasSuffix(name, ".go") {
+				continue
+			}
+			dir := path.Dir(name)
+			if packages[dir] == nil {
+				packages[dir] = make(map[string]string)
+			}
+			packages[dir][name] = content
+		}
+	}
+
+	fset := token.NewFileSet()
+	imp := &stubImporter{fset: fset, source: importer.ForCompiler(fset, "source", nil).(types.ImporterFrom), stubs: make(map[string]*types.Package)}
+	for dir, files := range packages {
+		var errs []string
+		conf := types.Config{
+			Importer: imp,
+			Error: func(err error) {
+				errs = append(errs, err.Error())
+			},
+		}
+
+		// the errors are collected by conf.Error, the first one is returned again
+		conf.Check(dir, fset, parseFiles(t, fset, files), nil)
+		for _, err := range errs {
+			t.Errorf("%s", err)
+		}
+	}
+}
+
+// sdlDefinitionRegexp matches the definitions and the type extensions of an SDL file.
+var sdlDefinitionRegexp = regexp.MustCompile(`(?m)^(extend )?(type|input|enum|interface|union|scalar) (\w+)`)
+
+// sdlReferenceRegexp matches the types referred by the fields, arguments, unions and interfaces of an SDL file.
+var sdlReferenceRegexp = regexp.MustCompile(`(?:: \[*|[=|] |implements )(\w+)`)
+
+// sdlDescriptionRegexp matches the block descriptions of an SDL file.
+var sdlDescriptionRegexp = regexp.MustCompile(`(?s)""".*?"""`)
+
+func checkSDL(t *testing.T, fdset *descriptor.FileDescriptorSet, targets []string, generated map[string]string) {
+	/*
+		the SDL files of a package are merged by the tools reading them, so a type is defined by one of them only
+		the files of all the packages make one schema, every type they refer to is defined by one of them, built in or
+		imported, the types of the imported files are defined by the SDL generated for their own package
+	*/
+
+	schema := map[string]bool{"Boolean": true, "Float": true, "ID": true, "Int": true, "String": true}
+	isTarget := make(map[string]bool)
+	for _, name := range targets {
+		isTarget[name] = true
+	}
+	for _, file := range fdset.File {
+		if isTarget[file.GetName()] {
+			continue
+		}
+		for _, enum := range file.EnumType {
+			schema[enum.GetName()] = true
+		}
+		for _, message := range file.MessageType {
+			schema[message.GetName()] = true
+		}
+	}
+
+	packages := make(map[string][]string)
+	for name, content := range generated {
+		if strings.HasSuffix(name, ".graphql") && !strings.HasSuffix(name, ".operations.graphql") {
+			packages[path.Dir(name)] = append(packages[path.Dir(name)], content)
+		}
+	}
+
+	var referred []string
+	for dir, files := range packages {
+		defined := make(map[string]bool)
+		var extended []string
+		for _, content := range files {
+			for _, match := range sdlDefinitionRegexp.FindAllStringSubmatch(content, -1) {
+				if match[1] != "" {
+					extended = append(extended, match[3])
+					continue
+				}
+				if defined[match[3]] {
+					t.Errorf("%s: %s is defined by more than one SDL file", dir, match[3])
+				}
+				defined[match[3]] = true
+				schema[match[3]] = true
+			}
+			for _, match := range sdlReferenceRegexp.FindAllStringSubmatch(sdlDescriptionRegexp.ReplaceAllString(content, ""), -1) {
+				referred = append(referred, match[1])
+			}
+		}
+		for _, name := range extended {
+			if !defined[name] {
+				t.Errorf("%s: %s is extended but not defined", dir, name)
+			}
+		}
+	}
+
+	for _, name := range referred {
+		if !schema[name] {
+			t.Errorf("%s is referred but not defined", name)
+		}
+	}
+}
+
+func parseFiles(t *testing.T, fset *token.FileSet, files map[string]string) []*ast.File {
+	var names []string
+	for name := range files {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var parsed []*ast.File
+	for _, name := range names {
+		file, err := parser.ParseFile(fset, name, files[name], 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+		parsed = append(parsed, file)
+	}
+
+	return parsed
+}
+
+// stubImporter imports the packages stubbed in testdata/stubs from their stub, others from source.
+type stubImporter struct {
+	fset   *token.FileSet
+	source types.ImporterFrom
+	stubs  map[string]*types.Package
+}
+
+func (i *stubImporter) Import(importPath string) (*types.Package, error) {
+	return i.ImportFrom(importPath, ".", 0)
+}
+
+func (i *stubImporter) ImportFrom(importPath, dir string, mode types.ImportMode) (*types.Package, error) {
+	if pkg, ok := i.stubs[importPath]; ok {
+		return pkg, nil
+	}
+
+	stub := filepath.Join("testdata", "stubs", filepath.FromSlash(importPath))
+	if _, err := os.Stat(stub); err != nil {
+		return i.source.ImportFrom(importPath, ".", mode)
+	}
+
+	names, err := filepath.Glob(filepath.Join(stub, "*.go"))
+	if err != nil {
+		return nil, err
+	}
+
+	var files []*ast.File
+	for _, name := range names {
+		file, err := parser.ParseFile(i.fset, name, nil, 0)
+		if err != nil {
+			return nil, err
+		}
+		files = append(files, file)
+	}
+
+	conf := types.Config{Importer: i}
+	pkg, err := conf.Check(importPath, i.fset, files, nil)
+	if err != nil {
+		return nil, err
+	}
+	i.stubs[importPath] = pkg
+
+	return pkg, nil
+}
@@ -0,0 +1,184 @@
+package main
+
+import (
+	"fmt"
+
+	pgs "github.com/lyft/protoc-gen-star"
+)
+
+// jsonTypes maps the google.protobuf types registered as Map scalar to their go package without go_package.
+var jsonTypes = map[string]string{
+	".google.protobuf.Struct":    "github.com/golang/protobuf/ptypes/struct;structpb",
+	".google.protobuf.Value":     "github.com/golang/protobuf/ptypes/struct;structpb",
+	".google.protobuf.ListValue": "github.com/golang/protobuf/ptypes/struct;structpb",
+	".google.protobuf.Any":       "github.com/golang/protobuf/ptypes/any",
+}
+
+type JSONType struct {
+	Name    string
+	GoType  string
+	Import  string
+	Package string
+}
+
+type JSONField struct {
+	FieldName string
+	Name      string
+	Type      string
+	Func      string
+}
+
+type AnyMember struct {
+	Name     string
+	TypeName string
+}
+
+func (m *jaalModule) AnyStrategy() (string, error) {
+	// returns the strategy used to expose google.protobuf.Any, json by default
+
+	switch strategy := m.Parameters().Str("any"); strategy {
+	case "", "json":
+		return "json", nil
+	case "union":
+		return strategy, nil
+	default:
+		return "", fmt.Errorf("unknown any strategy %s, expected json or union", strategy)
+	}
+}
+
+func (m *jaalModule) JSONOf(message pgs.Message) *JSONType {
+	// returns the JSON type of a message, nil if message is not registered as Map scalar
+
+	fallback, ok := jsonTypes[message.FullyQualifiedName()]
+	if !ok {
+		return nil
+	}
+
+	importPath, pkg := m.GoImport(message.File(), fallback)
+
+	return &JSONType{
+		Name:    message.Name().String(),
+		GoType:  pkg + "." + message.Name().String(),
+		Import:  importPath,
+		Package: pkg,
+	}
+}
+
+func (m *jaalModule) FieldJSON(field pgs.Field) *JSONType {
+	// returns the JSON type of a singular or repeated field, nil if field is not a Map scalar
+
+	if field.Type().IsMap() {
+		return nil
+	}
+
+	if field.Type().IsRepeated() {
+		if !field.Type().Element().IsEmbed() {
+			return nil
+		}
+		return m.JSONOf(field.Type().Element().Embed())
+	}
+
+	if !field.Type().IsEmbed() {
+		return nil
+	}
+	return m.JSONOf(field.Type().Embed())
+}
+
+func (m *jaalModule) jsonField(field pgs.Field, fieldName string, jsonType *JSONType, input bool) (JSONField, error) {
+	/*
+		returns the registration of a JSON field
+		inputs are unmarshalled from JSON, payloads are marshalled to JSON or resolved to AnyUnion
+	*/
+
+	list := ""
+	if field.Type().IsRepeated() {
+		list = "[]"
+	}
+
+	jsonField := JSONField{FieldName: fieldName, Name: field.Name().UpperCamelCase().String()}
+	if input {
+		jsonField.Type, jsonField.Func = list+"*schemabuilder.Map", "unmarshal"+jsonType.Name
+	} else {
+		jsonField.Type, jsonField.Func = list+"*schemabuilder.Map", "marshal"+jsonType.Name
+
+		strategy, err := m.AnyStrategy()
+		if err != nil {
+			return JSONField{}, err
+		}
+		if jsonType.Name == "Any" && strategy == "union" {
+			jsonField.Type, jsonField.Func = list+"*AnyUnion", "anyUnion"
+		}
+	}
+
+	if list != "" {
+		jsonField.Func += "s"
+	}
+
+	return jsonField, nil
+}
+
+func (m *jaalModule) PackageJSONTypes(files []pgs.File) []JSONType {
+	// returns the JSON types used by the fields of the files of a package
+
+	var jsonTypes []JSONType
+	added := make(map[string]bool)
+
+	for _, file := range files {
+		for _, message := range file.AllMessages() {
+			for _, field := range message.Fields() {
+				jsonType := m.FieldJSON(field)
+				if value := m.mapValueMessage(field); value != nil {
+					// map values are converted by map entries
+					jsonType = m.JSONOf(value)
+				}
+				if jsonType == nil || added[jsonType.Name] {
+					continue
+				}
+
+				added[jsonType.Name] = true
+				jsonTypes = append(jsonTypes, *jsonType)
+			}
+		}
+	}
+
+	return jsonTypes
+}
+
+func (m *jaalModule) PackageAnyMembers(files []pgs.File) ([]AnyMember, error) {
+	// returns the members of AnyUnion, the payloads of the files of a package, empty unless Any is exposed as union
+
+	strategy, err := m.AnyStrategy()
+	if err != nil {
+		return nil, err
+	} else if strategy != "union" {
+		return nil, nil
+	}
+
+	anyUsed := false
+	for _, jsonType := range m.PackageJSONTypes(files) {
+		anyUsed = anyUsed || jsonType.Name == "Any"
+	}
+	if !anyUsed {
+		return nil, nil
+	}
+
+	var members []AnyMember
+	for _, file := range files {
+		for _, message := range file.AllMessages() {
+			if skip, err := m.GetSkipOption(message); err != nil {
+				return nil, err
+			} else if skip {
+				continue
+			}
+
+			typeName, err := m.PayloadObjectName(message)
+			if err != nil {
+				return nil, err
+			}
+
+			members = append(members, AnyMember{Name: m.Context.Name(message).String(), TypeName: typeName})
+		}
+	}
+
+	return members, nil
+}
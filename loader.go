@@ -0,0 +1,143 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/golang/protobuf/proto"
+	pgs "github.com/lyft/protoc-gen-star"
+	pbt "go.appointy.com/protoc-gen-jaal/schema"
+)
+
+type Loader struct {
+	Name        string
+	Field       string
+	Key         string
+	KeyType     string
+	Client      string
+	ClientType  string
+	Method      string
+	RequestType string
+	Keys        string
+	Items       string
+	FullMethod  string
+}
+
+func (m *jaalModule) GetLoaderOption(message pgs.Message) (*pbt.Loader, error) {
+	//returns loader option of a message, nil if message has no loader
+
+	opt := message.Descriptor().GetOptions()
+	if opt == nil {
+		return nil, nil
+	}
+
+	x, err := proto.GetExtension(opt, pbt.E_Loader)
+	if err != nil {
+		if err == proto.ErrMissingExtension {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("%s: %v", message.FullyQualifiedName(), err)
+	}
+
+	return x.(*pbt.Loader), nil
+}
+
+func (m *jaalModule) loaderItems(message pgs.Message, response pgs.Message, name string) (pgs.Field, error) {
+	// returns the repeated field of response holding the loaded objects of message
+
+	var items pgs.Field
+	for _, field := range response.Fields() {
+		if name != "" && field.Name().String() != name {
+			continue
+		}
+
+		if !field.Type().IsRepeated() || !field.Type().Element().IsEmbed() || field.Type().Element().Embed().FullyQualifiedName() != message.FullyQualifiedName() {
+			if name != "" {
+				return nil, fmt.Errorf("%s: loader items %s must be a repeated %s", message.FullyQualifiedName(), name, message.Name())
+			}
+			continue
+		}
+
+		if items != nil {
+			return nil, fmt.Errorf("%s: loader items must be set, more than one repeated %s found in %s", message.FullyQualifiedName(), message.Name(), response.Name())
+		}
+		items = field
+	}
+
+	if items == nil {
+		return nil, fmt.Errorf("%s: loader items must be a repeated %s of %s", message.FullyQualifiedName(), message.Name(), response.Name())
+	}
+
+	return items, nil
+}
+
+func (m *jaalModule) LoaderOf(message pgs.Message) (*Loader, error) {
+	/*
+		returns the loader of a message tagged with loader, nil if message has no loader
+		the keys collected from the resolved fields are set on keys of the request of rpc, the returned objects are matched by key
+	*/
+
+	option, err := m.GetLoaderOption(message)
+	if err != nil || option == nil {
+		return nil, err
+	}
+
+	rpc, err := m.resolveRPC(message, option.GetRpc())
+	if err != nil {
+		return nil, err
+	}
+
+	key, err := m.connectionField(message, option.GetKey(), "id")
+	if err != nil {
+		return nil, err
+	}
+	protoType := key.Type().ProtoType()
+	if key.Type().IsRepeated() || key.Type().IsMap() || protoType == pgs.MessageT || protoType == pgs.EnumT || protoType == pgs.BytesT || m.InOneOf(key) || m.IsProto3Optional(key) {
+		return nil, fmt.Errorf("%s: loader key %s must be a singular scalar field", message.FullyQualifiedName(), key.Name())
+	}
+
+	keys, err := m.connectionField(rpc.Input(), option.GetKeys(), "ids")
+	if err != nil {
+		return nil, err
+	} else if !keys.Type().IsRepeated() || keys.Type().IsMap() || keys.Type().Element().ProtoType() != protoType {
+		return nil, fmt.Errorf("%s: loader keys %s must be a repeated field of %s with the type of key %s", message.FullyQualifiedName(), keys.Name(), rpc.Input().Name(), key.Name())
+	}
+
+	items, err := m.loaderItems(message, rpc.Output(), option.GetItems())
+	if err != nil {
+		return nil, err
+	}
+
+	name := m.Context.Name(message).String()
+
+	return &Loader{
+		Name:        name,
+		Field:       pgs.Name(name).LowerCamelCase().String(),
+		Key:         key.Name().UpperCamelCase().String(),
+		KeyType:     m.Context.Type(key).String(),
+		Client:      rpc.Service().Name().LowerCamelCase().String() + "Client",
+		ClientType:  rpc.Service().Name().UpperCamelCase().String() + "Client",
+		Method:      rpc.Name().UpperCamelCase().String(),
+		RequestType: m.Context.Name(rpc.Input()).String(),
+		Keys:        keys.Name().UpperCamelCase().String(),
+		Items:       items.Name().UpperCamelCase().String(),
+		FullMethod:  m.FullMethod(rpc),
+	}, nil
+}
+
+func (m *jaalModule) PackageLoaders(files []pgs.File) ([]Loader, error) {
+	// returns the loaders of the messages of the files of a package
+
+	var loaders []Loader
+	for _, file := range files {
+		for _, message := range file.AllMessages() {
+			loader, err := m.LoaderOf(message)
+			if err != nil {
+				return nil, err
+			} else if loader != nil {
+				loaders = append(loaders, *loader)
+			}
+		}
+	}
+
+	return loaders, nil
+}
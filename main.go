@@ -1,13 +1,21 @@
 package main
 
 import (
+	"io"
+	"os"
+
 	pgs "github.com/lyft/protoc-gen-star"
 	pgsgo "github.com/lyft/protoc-gen-star/lang/go"
 )
 
 func main() {
-	pgs.Init(pgs.DebugEnv("DEBUG")).
+	newGenerator(os.Stdin, os.Stdout).Render()
+}
+
+func newGenerator(in io.Reader, out io.Writer) *pgs.Generator {
+	// returns the generator of the plugin, reading the request of protoc from in and writing the response to out
+
+	return pgs.Init(pgs.DebugEnv("DEBUG"), pgs.ProtocInput(in), pgs.ProtocOutput(featureWriter{out})).
 		RegisterModule(&jaalModule{ModuleBase: &pgs.ModuleBase{}}).
-		RegisterPostProcessor(pgsgo.GoFmt()).
-		Render()
+		RegisterPostProcessor(pgsgo.GoFmt())
 }
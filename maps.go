@@ -0,0 +1,227 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/golang/protobuf/proto"
+	pgs "github.com/lyft/protoc-gen-star"
+	pbt "go.appointy.com/protoc-gen-jaal/schema"
+)
+
+type MapEntry struct {
+	Name           string
+	PayloadObjName string
+	InputObjName   string
+	Key            string
+	Value          string
+	PayloadType    string
+	PayloadVal     string
+	PayloadErr     bool
+	InputType      string
+	InputVal       string
+	InputErr       bool
+}
+
+func (m *jaalModule) MapStrategy() (string, error) {
+	// returns the strategy used to expose map fields, json by default
+
+	switch strategy := m.Parameters().Str("maps"); strategy {
+	case "", "json":
+		return "json", nil
+	case "entries":
+		return strategy, nil
+	default:
+		return "", fmt.Errorf("unknown maps strategy %s, expected json or entries", strategy)
+	}
+}
+
+func (m *jaalModule) GetMapEntriesOption(field pgs.Field) (bool, error) {
+	//returns map_entries option for a message field
+
+	opt := field.Descriptor().GetOptions()
+	if opt == nil {
+		return false, nil
+	}
+
+	x, err := proto.GetExtension(opt, pbt.E_MapEntries)
+	if err != nil {
+		if err == proto.ErrMissingExtension {
+			return false, nil
+		}
+
+		return false, fmt.Errorf("%s: %v", field.FullyQualifiedName(), err)
+	}
+
+	option := *x.(*bool)
+	return option, nil
+}
+
+func (m *jaalModule) IsMapEntries(field pgs.Field) (bool, error) {
+	// returns true if a map field is exposed as a list of entries, by the maps parameter or the map_entries option
+
+	if !field.Type().IsMap() {
+		return false, nil
+	}
+
+	strategy, err := m.MapStrategy()
+	if err != nil {
+		return false, err
+	} else if strategy == "entries" {
+		return true, nil
+	}
+
+	return m.GetMapEntriesOption(field)
+}
+
+func (m *jaalModule) mapValueMessage(field pgs.Field) pgs.Message {
+	// returns the message of the values of a map field, nil if field is not a map of messages
+
+	if !field.Type().IsMap() || !field.Type().Element().IsEmbed() {
+		return nil
+	}
+
+	return field.Type().Element().Embed()
+}
+
+func (m *jaalModule) mapValueType(field pgs.Field) string {
+	// returns the go type of the values of a map field as generated by protoc-gen-go
+
+	element := field.Type().Element()
+	if element.IsEmbed() {
+		goPkg := m.GetGoPackageOfFiles(field.File(), element.Embed().File())
+		if goPkg != "" {
+			goPkg += "."
+		}
+		return "*" + goPkg + m.Context.Name(element.Embed()).String()
+	} else if element.IsEnum() {
+		goPkg := m.GetGoPackageOfFiles(field.File(), element.Enum().File())
+		if goPkg != "" {
+			goPkg += "."
+		}
+		return goPkg + m.Context.Name(element.Enum()).String()
+	}
+
+	return m.fieldElementType(element)
+}
+
+func (m *jaalModule) MapEntryOf(field pgs.Field) (*MapEntry, error) {
+	/*
+		returns the entry of a map field exposed as a list of entries
+		the entry is named after the message and the field, e.g. Customer_LabelsEntry registered as CustomerLabelsEntry
+	*/
+
+	payloadName, err := m.PayloadObjectName(field.Message())
+	if err != nil {
+		return nil, err
+	}
+
+	entryName := field.Name().UpperCamelCase().String() + "Entry"
+	entry := &MapEntry{
+		Name:           m.Context.Name(field.Message()).String() + "_" + entryName,
+		PayloadObjName: payloadName + entryName,
+		InputObjName:   payloadName + entryName + "Input",
+		Key:            m.fieldElementType(field.Type().Key()),
+		Value:          m.mapValueType(field),
+		PayloadVal:     "in.Value",
+		InputVal:       "source",
+	}
+	entry.PayloadType, entry.InputType = entry.Value, entry.Value
+
+	element := field.Type().Element()
+	if !element.IsEmbed() {
+		if element.ProtoType() == pgs.BytesT {
+			entry.PayloadType, entry.PayloadVal = "*schemabuilder.Bytes", "&schemabuilder.Bytes{Value: in.Value}"
+			entry.InputType, entry.InputVal = "*schemabuilder.Bytes", "source.Value"
+		}
+		return entry, nil
+	}
+
+	if wrapper := m.WrapperOf(element.Embed()); wrapper != nil {
+		entry.PayloadType, entry.PayloadVal = "*"+wrapper.Type, "unwrap"+wrapper.Name+"(in.Value)"
+		entry.InputType, entry.InputVal = "*"+wrapper.Type, "wrap"+wrapper.Name+"(source)"
+	} else if wkt := m.WKTOf(element.Embed()); wkt != nil && wkt.Name == "FieldMask" {
+		entry.PayloadVal, entry.InputVal = "gtypes.ModifyFieldMask(in.Value)", "gtypes.ModifyFieldMask(source)"
+	} else if wkt != nil {
+		entry.PayloadType, entry.PayloadVal = "*schemabuilder."+wkt.Name, "fromProto"+wkt.Name+"(in.Value)"
+		entry.InputType, entry.InputVal = "*schemabuilder."+wkt.Name, "toProto"+wkt.Name+"(source)"
+	} else if jsonType := m.JSONOf(element.Embed()); jsonType != nil {
+		payload, err := m.jsonField(field, "value", jsonType, false)
+		if err != nil {
+			return nil, err
+		}
+		input, err := m.jsonField(field, "value", jsonType, true)
+		if err != nil {
+			return nil, err
+		}
+		entry.PayloadType, entry.PayloadVal, entry.PayloadErr = payload.Type, payload.Func+"(in.Value)", true
+		entry.InputType, entry.InputVal, entry.InputErr = input.Type, input.Func+"(source)", true
+	}
+
+	return entry, nil
+}
+
+func (m *jaalModule) mapEntryFuncs(field pgs.Field, target pgs.File) (string, string, string, error) {
+	// returns the graphql side go type, to map and to list helpers of a map field exposed as a list of entries, as seen from the target file
+
+	entry, err := m.MapEntryOf(field)
+	if err != nil {
+		return "", "", "", err
+	}
+
+	goPkg := m.GetGoPackageOfFiles(target, field.File())
+	if goPkg != "" {
+		goPkg += "."
+	}
+
+	return "[]*" + goPkg + entry.Name, goPkg + entry.Name + "Map", goPkg + entry.Name + "List", nil
+}
+
+func (m *jaalModule) MapEntries(message pgs.Message) ([]MapEntry, error) {
+	/*
+		returns the entries of the map fields of a message exposed as a list of entries
+		entries of skipped messages are generated as well, they may still be used by query arguments and mutation inputs
+	*/
+
+	var entries []MapEntry
+	for _, field := range message.Fields() {
+		if ok, err := m.IsMapEntries(field); err != nil {
+			return nil, err
+		} else if !ok {
+			continue
+		}
+
+		entry, err := m.MapEntryOf(field)
+		if err != nil {
+			return nil, err
+		}
+		entries = append(entries, *entry)
+	}
+
+	return entries, nil
+}
+
+func (m *jaalModule) MapEntryType(message pgs.Message, initFunctionsName map[string]bool) (string, error) {
+	// returns generated template(map entries) for the map fields of a message exposed as a list of entries
+
+	entries, err := m.MapEntries(message)
+	if err != nil {
+		return "", err
+	} else if len(entries) == 0 {
+		return "", nil
+	}
+
+	for _, entry := range entries {
+		initFunctionsName["RegisterPayload"+entry.Name] = true
+		initFunctionsName["RegisterInput"+entry.Name] = true
+	}
+
+	tmp := getMapEntryTemplate()
+	buf := &bytes.Buffer{}
+
+	if err := tmp.Execute(buf, entries); err != nil {
+		return "", err
+	}
+
+	return buf.String(), nil
+}
@@ -3,8 +3,11 @@ package main
 import (
 	"bytes"
 	"fmt"
-	"os"
+	"path"
+	"regexp"
 	"strings"
+	"unicode"
+	"unicode/utf8"
 
 	"github.com/golang/protobuf/proto"
 	pgd "github.com/golang/protobuf/protoc-gen-go/descriptor"
@@ -27,6 +30,7 @@ type MsgFields struct {
 	FieldName  string
 	FuncPara   string
 	TargetVal  string
+	Nullable   bool
 }
 
 type UnionObject struct {
@@ -40,11 +44,7 @@ type InputMap struct {
 	TargetVal  string
 	Key        string
 	Value      string
-}
-
-type Duration struct {
-	FieldName string
-	Name      string
+	Wrapper    string
 }
 
 type Id struct {
@@ -56,9 +56,10 @@ type InputClass struct {
 	Type         string
 	InputObjName string
 	Maps         []InputMap
-	Durations    []Duration
 	Fields       []MsgFields
 	Ids          []Id
+	NodeIds      []NodeId
+	JSONs        []JSONField
 }
 
 func (m *jaalModule) scalarMap(scalar string) string {
@@ -111,8 +112,8 @@ func (m *jaalModule) fieldElementType(valKey pgs.FieldTypeElem) string {
 
 	case "TYPE_ENUM":
 
-		enum := valKey.Enum()
-		return enum.Name().String()
+		// nested enums are prefixed with their parents, e.g. Order_Status
+		return m.Context.Name(valKey.Enum()).String()
 
 	default:
 
@@ -122,10 +123,32 @@ func (m *jaalModule) fieldElementType(valKey pgs.FieldTypeElem) string {
 	}
 }
 
+func (m *jaalModule) enumPackagePrefix(target pgs.File, enum pgs.Enum) string {
+	// returns the go package of an enum followed by a dot if it is not generated in the package of target
+
+	if goPkg := m.GetGoPackageOfFiles(target, enum.File()); goPkg != "" {
+		return goPkg + "."
+	}
+	return ""
+}
+
+func (m *jaalModule) nestedPrefix(message pgs.Message) string {
+	// returns the prefix of the go name of a nested message, e.g. Customer_ for Customer.Address, empty for other messages
+
+	parent, ok := message.Parent().(pgs.Message)
+	if !ok {
+		return ""
+	}
+
+	return m.Context.Name(parent).String() + "_"
+}
+
 type PayloadFields struct {
 	FieldName string
 	FuncPara  string
 	TargetVal string
+	Null      string
+	NullElem  string
 }
 
 type OneOfFields struct {
@@ -142,27 +165,41 @@ type UnionObjectPayload struct {
 type PayloadMap struct {
 	FieldName string
 	TargetVal string
+	Key       string
+	Value     string
+	Wrapper   string
 }
 type Payload struct {
 	Name           string
 	Type           string
 	PayloadObjName string
+	Description    string
 	UnionObjects   []UnionObjectPayload
 	Maps           []PayloadMap
-	Durations      []Duration
 	Fields         []PayloadFields
 	Ids            []Id
+	JSONs          []JSONField
 }
 
 func (m *jaalModule) EnumType(enumData pgs.Enum, imports map[string]string, initFunctionsName map[string]bool) (string, error) {
 	// returns generated template in for a enum type
 
-	enumval := enum{Name: enumData.Name().UpperCamelCase().String()}
+	// nested enums are prefixed with their parents, e.g. Order_Status
+	enumval := enum{Name: m.Context.Name(enumData).String()}
 
 	initFunctionsName["Register"+enumval.Name] = true
 
 	for _, val := range enumData.Values() {
-		enumval.Values = append(enumval.Values, Value{Value: val.Name().String(), Index: val.Value()})
+		if hidden, err := m.IsHiddenValue(val); err != nil {
+			return "", err
+		} else if hidden {
+			continue
+		}
+		name, err := m.EnumValueName(val)
+		if err != nil {
+			return "", err
+		}
+		enumval.Values = append(enumval.Values, Value{Value: name, Index: val.Value()})
 	}
 
 	tmp := getEnumTemplate()
@@ -184,6 +221,22 @@ type Oneof struct {
 	TargetVal                   string
 }
 
+func (m *jaalModule) Description(entity pgs.Entity) string {
+	// returns leading comments of an entity, used as its description on graphql schema
+
+	info := entity.SourceCodeInfo()
+	if info == nil {
+		return ""
+	}
+
+	lines := strings.Split(strings.TrimSpace(info.LeadingComments()), "\n")
+	for i, line := range lines {
+		lines[i] = strings.TrimSpace(line)
+	}
+
+	return strings.Join(lines, "\n")
+}
+
 func (m *jaalModule) GetSkipOption(message pgs.Message) (bool, error) {
 	//returns true if a message have skip flag as true or no skip option
 	//returns false if message have skip flag as false
@@ -205,7 +258,7 @@ func (m *jaalModule) GetSkipOption(message pgs.Message) (bool, error) {
 
 		}
 
-		return false, err
+		return false, fmt.Errorf("%s: %v", message.FullyQualifiedName(), err)
 
 	}
 
@@ -232,7 +285,7 @@ func (m *jaalModule) GetMessageTypeOption(message pgs.Message) (bool, string, er
 
 		}
 
-		return false, "", err
+		return false, "", fmt.Errorf("%s: %v", message.FullyQualifiedName(), err)
 
 	}
 
@@ -246,7 +299,7 @@ func (m *jaalModule) OneofInputType(inputData pgs.Message, imports map[string]st
 	*/
 	var oneOfArr []Oneof
 
-	for _, oneof := range inputData.OneOfs() {
+	for _, oneof := range m.OneOfs(inputData) {
 
 		for _, fields := range oneof.Fields() {
 			//checks skip_input field option
@@ -282,16 +335,15 @@ func (m *jaalModule) OneofInputType(inputData pgs.Message, imports map[string]st
 				targetVal = "*source"
 			}
 			fieldFuncSecondParaFuncPara = goPkg + fieldFuncSecondParaFuncPara
-			if fieldFuncSecondParaFuncPara == "field_mask.FieldMask" {
+			if wrapper := m.FieldWrapper(fields); wrapper != nil {
+				fieldFuncSecondParaFuncPara = wrapper.Type
+				targetVal = "wrap" + wrapper.Name + "(source)"
+			} else if wkt := m.FieldWKT(fields); wkt != nil && wkt.Name == "FieldMask" {
+				fieldFuncSecondParaFuncPara = wkt.GoType
 				targetVal = "gtypes.ModifyFieldMask(source)"
-			} else if strings.HasSuffix(fieldFuncSecondParaFuncPara, "duration.Duration") {
-				fieldFuncSecondParaFuncPara = fieldFuncSecondParaFuncPara[:len(fieldFuncSecondParaFuncPara)-17]
-				fieldFuncSecondParaFuncPara += "schemabuilder.Duration"
-				targetVal = "(*duration.Duration)(" + targetVal + ")"
-			} else if strings.HasSuffix(fieldFuncSecondParaFuncPara, "timestamp.Timestamp") {
-				fieldFuncSecondParaFuncPara = fieldFuncSecondParaFuncPara[:len(fieldFuncSecondParaFuncPara)-19]
-				fieldFuncSecondParaFuncPara += "schemabuilder.Timestamp"
-				targetVal = "(*timestamp.Timestamp)(" + targetVal + ")"
+			} else if wkt != nil {
+				fieldFuncSecondParaFuncPara = "schemabuilder." + wkt.Name
+				targetVal = "toProto" + wkt.Name + "(source)"
 			} else if strings.HasSuffix(fieldFuncSecondParaFuncPara, "byte") {
 				fieldFuncSecondParaFuncPara = "schemabuilder.Bytes"
 				targetVal = "source.Value"
@@ -327,7 +379,7 @@ func (m *jaalModule) OneofPayloadType(inputData pgs.Message, imports map[string]
 	*/
 	var oneOfArr []OneofPayload
 
-	for _, oneof := range inputData.OneOfs() {
+	for _, oneof := range m.OneOfs(inputData) {
 
 		for _, fields := range oneof.Fields() {
 			//checks skip_payload field option
@@ -361,8 +413,15 @@ func (m *jaalModule) OneofPayloadType(inputData pgs.Message, imports map[string]
 			}
 			fieldFuncSecondFuncReturn = goPkg + fieldFuncSecondFuncReturn
 			fieldFuncReturn := fields.Name().UpperCamelCase().String()
-			if fieldFuncSecondFuncReturn == "*field_mask.FieldMask" {
+			if wrapper := m.FieldWrapper(fields); wrapper != nil {
+				fieldFuncSecondFuncReturn = "*" + wrapper.Type
+				fieldFuncReturn = "unwrap" + wrapper.Name + "(in." + fieldFuncReturn + ")"
+			} else if wkt := m.FieldWKT(fields); wkt != nil && wkt.Name == "FieldMask" {
+				fieldFuncSecondFuncReturn = "*" + wkt.GoType
 				fieldFuncReturn = "gtypes.ModifyFieldMask(in." + fieldFuncReturn + ")"
+			} else if wkt != nil {
+				fieldFuncSecondFuncReturn = "*schemabuilder." + wkt.Name
+				fieldFuncReturn = "fromProto" + wkt.Name + "(in." + fieldFuncReturn + ")"
 			} else if strings.HasSuffix(fieldFuncSecondFuncReturn, "byte") {
 				fieldFuncSecondFuncReturn = "*schemabuilder.Bytes"
 				fieldFuncReturn = "&schemabuilder.Bytes{Value:in." + fieldFuncReturn + "}"
@@ -391,7 +450,7 @@ func (m *jaalModule) UnionStruct(inputData pgs.Message, imports map[string]strin
 
 	var unionObjects []UnionObject
 
-	for _, oneof := range inputData.OneOfs() {
+	for _, oneof := range m.OneOfs(inputData) {
 
 		unionName := "Union"
 		msgName := oneof.Message().Name().UpperCamelCase().String()
@@ -428,7 +487,7 @@ func (m *jaalModule) GetMessageName(message pgs.Message) (string, error) {
 	if opt != nil {
 		x, err := proto.GetExtension(opt, pbt.E_Name)
 		if err != nil && err != proto.ErrMissingExtension {
-			return "", err
+			return "", fmt.Errorf("%s: %v", message.FullyQualifiedName(), err)
 		}
 		if x != nil {
 			return *x.(*string), nil
@@ -437,6 +496,34 @@ func (m *jaalModule) GetMessageName(message pgs.Message) (string, error) {
 	return "", nil
 }
 
+func (m *jaalModule) InputObjectName(message pgs.Message, PossibleReqObjects map[string]bool) (string, error) {
+	// returns name of the input object registered for a message
+
+	newName, err := m.GetMessageName(message)
+	if err != nil {
+		return "", err
+	} else if newName != "" {
+		return newName + "Input", nil
+	} else if PossibleReqObjects[message.Name().String()] {
+		return m.InputAppend(message.Name().UpperCamelCase().String()), nil
+	}
+
+	return message.Name().UpperCamelCase().String() + "Input", nil
+}
+
+func (m *jaalModule) PayloadObjectName(message pgs.Message) (string, error) {
+	// returns name of the payload object registered for a message
+
+	newName, err := m.GetMessageName(message)
+	if err != nil {
+		return "", err
+	} else if newName != "" {
+		return newName, nil
+	}
+
+	return message.Name().UpperCamelCase().String(), nil
+}
+
 func (m *jaalModule) InputType(inputData pgs.Message, imports map[string]string, PossibleReqObjects map[string]bool, initFunctionsName map[string]bool, typeCastMap map[string]string) (string, error) {
 	// returns generated template(Input) in for a message type
 
@@ -450,40 +537,23 @@ func (m *jaalModule) InputType(inputData pgs.Message, imports map[string]string,
 	}
 
 	// handles embedded messages
-	fullyQualifiedName := inputData.FullyQualifiedName()
-	embeddedMessageParent := ""
+	msg := InputClass{Name: m.nestedPrefix(inputData) + inputData.Name().UpperCamelCase().String()}
 
-	names := strings.Split(fullyQualifiedName, ".")
-
-	if inputData.Parent().Name().String() == names[len(names)-2] {
-		embeddedMessageParent = strings.Join(names[1:len(names)-1], "_") + "_"
+	inputObjName, err := m.InputObjectName(inputData, PossibleReqObjects)
+	if err != nil {
+		return "", err
 	}
-
-	msg := InputClass{Name: embeddedMessageParent + inputData.Name().UpperCamelCase().String()}
-
-	newName, err := m.GetMessageName(inputData)
+	msg.InputObjName = inputObjName
+	nodeTypeName, err := m.NodeTypeName(inputData)
 	if err != nil {
 		return "", err
-
-	} else if newName != "" {
-
-		msg.InputObjName = newName + "Input"
-
-	} else if PossibleReqObjects[inputData.Name().String()] {
-
-		msg.InputObjName = m.InputAppend(inputData.Name().UpperCamelCase().String())
-
-	} else {
-
-		msg.InputObjName = inputData.Name().UpperCamelCase().String() + "Input"
-
 	}
 
 	initFunctionsName["RegisterInput"+msg.Name] = true
 
 	var maps []InputMap
 
-	for _, oneof := range inputData.OneOfs() {
+	for _, oneof := range m.OneOfs(inputData) {
 
 		unionName := "Union"
 		msgName := oneof.Message().Name().UpperCamelCase().String()
@@ -504,7 +574,7 @@ func (m *jaalModule) InputType(inputData pgs.Message, imports map[string]string,
 
 	}
 
-	for _, fields := range inputData.NonOneOfFields() {
+	for _, fields := range m.NonOneOfFields(inputData) {
 		//m.Log(fields.Name(),fields.Type().IsEmbed())
 
 		//checks skip_input field option
@@ -516,7 +586,6 @@ func (m *jaalModule) InputType(inputData pgs.Message, imports map[string]string,
 
 		overrideFieldName, nameToBeOverridden, err := m.getFieldNameOption(fields)
 		if err != nil {
-			m.Log("In Input")
 			return "", err
 		}
 
@@ -557,6 +626,16 @@ func (m *jaalModule) InputType(inputData pgs.Message, imports map[string]string,
 
 		if strings.ToLower(fields.Name().String()) == "id" || idOption {
 
+			if m.IsProto3Optional(fields) {
+				return "", fmt.Errorf("%s: id can not be optional", fields.FullyQualifiedName())
+			}
+
+			if nodeTypeName != "" && !fields.Type().IsRepeated() {
+				// global ids of nodes are decoded to the id of the message
+				msg.NodeIds = append(msg.NodeIds, NodeId{FieldName: fieldName, Name: targetName, TypeName: nodeTypeName})
+				continue
+			}
+
 			msgArg += "schemabuilder.ID"
 			tVal += "source.Value"
 			flag = false
@@ -579,26 +658,39 @@ func (m *jaalModule) InputType(inputData pgs.Message, imports map[string]string,
 					msgArg += "."
 
 				} else {
-					if strings.Split(tObj.Embed().FullyQualifiedName(), ".")[len(strings.Split(tObj.Embed().FullyQualifiedName(), "."))-2] == tObj.Embed().Parent().Name().String() {
-						names := strings.Split(fields.FullyQualifiedName(), ".")
-						tembeddedMessageParent := strings.Join(names[1:len(names)-1], "_") + "_"
-						msgArg += tembeddedMessageParent
-					}
+					msgArg += m.nestedPrefix(tObj.Embed())
 				}
 			}
 
+			if tObj.IsEnum() {
+				msgArg += m.enumPackagePrefix(inputData.File(), tObj.Enum())
+			}
 			ttype := m.fieldElementType(tObj)
 			msgArg += ttype
 
 		} else if fields.Type().IsMap() {
 			// TODO : Repeated case not handled
 
+			if entries, err := m.IsMapEntries(fields); err != nil {
+				return "", err
+			} else if entries {
+				// maps are registered as a list of key value entries
+				funcPara, toMap, _, err := m.mapEntryFuncs(fields, inputData.File())
+				if err != nil {
+					return "", err
+				}
+				msg.Fields = append(msg.Fields, MsgFields{TargetName: targetName, FieldName: fieldName, FuncPara: funcPara, TargetVal: toMap + "(source)"})
+				continue
+			}
+
 			goPkg := ""
 			if fields.Type().Element().IsEmbed() {
 				goPkg = m.GetGoPackageOfFiles(inputData.File(), fields.Type().Element().Embed().File())
 				if goPkg != "" {
 					goPkg += "."
 				}
+			} else if fields.Type().Element().IsEnum() {
+				goPkg = m.enumPackagePrefix(inputData.File(), fields.Type().Element().Enum())
 			}
 
 			asterik := ""
@@ -607,7 +699,12 @@ func (m *jaalModule) InputType(inputData pgs.Message, imports map[string]string,
 			}
 
 			value := asterik + goPkg + m.fieldElementType(fields.Type().Element())
-			maps = append(maps, InputMap{FieldName: fieldName, TargetVal: "*source", TargetName: targetName, Key: m.fieldElementType(fields.Type().Key()), Value: value})
+			wrapperType := ""
+			if wrapper := m.MapWrapper(fields); wrapper != nil {
+				// wrapped values are exchanged as nullable scalars
+				value, wrapperType = "*"+wrapper.ValueType, wrapper.GoType
+			}
+			maps = append(maps, InputMap{FieldName: fieldName, TargetVal: "*source", TargetName: targetName, Key: m.fieldElementType(fields.Type().Key()), Value: value, Wrapper: wrapperType})
 			continue
 		} else if fields.Descriptor().GetType().String() == "TYPE_MESSAGE" {
 
@@ -617,11 +714,7 @@ func (m *jaalModule) InputType(inputData pgs.Message, imports map[string]string,
 					msgArg += goPkg
 					msgArg += "."
 				} else {
-					if strings.Split(fields.FullyQualifiedName(), ".")[len(strings.Split(fields.FullyQualifiedName(), "."))-2] == fields.Type().Embed().Parent().Name().String() {
-						names := strings.Split(fields.FullyQualifiedName(), ".")
-						tembeddedMessageParent := strings.Join(names[1:len(names)-1], "_") + "_"
-						msgArg += tembeddedMessageParent
-					}
+					msgArg += m.nestedPrefix(fields.Type().Embed())
 				}
 			}
 
@@ -636,8 +729,7 @@ func (m *jaalModule) InputType(inputData pgs.Message, imports map[string]string,
 				goPkg += "."
 			}
 			flag = false
-			tmsg := strings.Split(fields.Descriptor().GetTypeName(), ".")
-			msgArg = goPkg + tmsg[len(tmsg)-1]
+			msgArg = goPkg + m.Context.Name(fields.Type().Enum()).String()
 
 		} else {
 
@@ -646,6 +738,11 @@ func (m *jaalModule) InputType(inputData pgs.Message, imports map[string]string,
 			flag = false
 		}
 
+		if m.IsPresenceField(fields) {
+			// optional fields are nil when unset
+			msgArg = "*" + msgArg
+		}
+
 		if flag {
 
 			tVal += "*"
@@ -657,27 +754,29 @@ func (m *jaalModule) InputType(inputData pgs.Message, imports map[string]string,
 			tVal += "source"
 
 		}
-		if strings.HasSuffix(msgArg, "timestamp.Timestamp") {
-			// handles special case of timestamp
-			//todo repeated case is not handled
-			msgArg = msgArg[:len(msgArg)-19]
-			msgArg += "schemabuilder.Timestamp"
-
-			tVal = "(*timestamp.Timestamp)(" + tVal + ")"
-		} else if strings.HasSuffix(msgArg, "duration.Duration") {
-			if fields.Type().IsRepeated() {
-				msgArg = msgArg[:len(msgArg)-17]
-				msgArg += "schemabuilder.Duration"
-				msg.Durations = append(msg.Durations, Duration{FieldName: fields.Name().LowerCamelCase().String(), Name: fields.Name().UpperCamelCase().String()})
-				continue
-			} else {
-				msgArg = msgArg[:len(msgArg)-17]
-				msgArg += "schemabuilder.Duration"
-				tVal = "(*duration.Duration)(" + tVal + ")"
+		if jsonType := m.FieldJSON(fields); jsonType != nil {
+			// free-form messages are registered as Map scalar
+			jsonField, err := m.jsonField(fields, fieldName, jsonType, true)
+			if err != nil {
+				return "", err
 			}
+			msg.JSONs = append(msg.JSONs, jsonField)
+			continue
+		}
 
-		} else if strings.HasSuffix(msgArg, "field_mask.FieldMask") {
+		if wrapper := m.FieldWrapper(fields); wrapper != nil {
+			// wrappers are registered as nullable scalars
+			funcPara, wrap, _ := m.wrapperFuncs(fields, wrapper)
+			msgArg = funcPara
+			tVal = wrap + "(source)"
+		} else if wkt := m.FieldWKT(fields); wkt != nil && wkt.Name == "FieldMask" {
+			msgArg = "*" + wkt.GoType
 			tVal = "gtypes.ModifyFieldMask(" + tVal + ")"
+		} else if wkt != nil {
+			// timestamps and durations are converted field wise
+			funcPara, toProto, _ := m.wktFuncs(fields, wkt)
+			msgArg = funcPara
+			tVal = toProto + "(source)"
 		} else if strings.HasSuffix(msgArg, "byte") {
 			msgArg = "*schemabuilder.Bytes"
 			tVal = "source.Value"
@@ -686,7 +785,15 @@ func (m *jaalModule) InputType(inputData pgs.Message, imports map[string]string,
 			msg.Ids = append(msg.Ids, Id{FieldName: fields.Name().LowerCamelCase().String(), Name: fields.Name().UpperCamelCase().String()})
 			continue
 		}
-		msg.Fields = append(msg.Fields, MsgFields{TargetName: targetName, FieldName: fieldName, FuncPara: msgArg, TargetVal: tVal})
+		inputField := MsgFields{TargetName: targetName, FieldName: fieldName, FuncPara: msgArg, TargetVal: tVal}
+		if hidesZero, err := m.HidesZero(fields); err != nil {
+			return "", err
+		} else if hidesZero {
+			// null is accepted for the hidden zero value
+			inputField.FuncPara = "*" + msgArg
+			inputField.Nullable = true
+		}
+		msg.Fields = append(msg.Fields, inputField)
 
 	}
 	// adds all maps
@@ -733,25 +840,19 @@ func (m *jaalModule) PayloadType(payloadData pgs.Message, imports map[string]str
 		return "", nil
 	}
 
-	fullyQualifiedName := payloadData.FullyQualifiedName()
-	names := strings.Split(fullyQualifiedName, ".")
-	embeddedMessageParent := ""
-	if payloadData.Parent().Name().String() == names[len(names)-2] {
-
-		embeddedMessageParent = strings.Join(names[1:len(names)-1], "_") + "_"
+	msg := Payload{Name: m.nestedPrefix(payloadData) + payloadData.Name().UpperCamelCase().String(), Description: m.Description(payloadData)}
+	payloadObjName, err := m.PayloadObjectName(payloadData)
+	if err != nil {
+		return "", err
 	}
-	msg := Payload{Name: embeddedMessageParent + payloadData.Name().UpperCamelCase().String()}
-	newName, err := m.GetMessageName(payloadData)
+	msg.PayloadObjName = payloadObjName
+	nodeTypeName, err := m.NodeTypeName(payloadData)
 	if err != nil {
 		return "", err
-	} else if newName != "" {
-		msg.PayloadObjName = newName
-	} else {
-		msg.PayloadObjName = payloadData.Name().UpperCamelCase().String()
 	}
 	initFunctionsName["RegisterPayload"+msg.Name] = true
 	var maps []PayloadMap
-	for _, oneof := range payloadData.OneOfs() {
+	for _, oneof := range m.OneOfs(payloadData) {
 
 		var oneofFields []OneOfFields
 
@@ -774,7 +875,7 @@ func (m *jaalModule) PayloadType(payloadData pgs.Message, imports map[string]str
 		msg.UnionObjects = append(msg.UnionObjects, UnionObjectPayload{FieldName: fieldName, SwitchName: switchName, FuncReturn: funcpara, Fields: oneofFields})
 
 	}
-	for _, fields := range payloadData.NonOneOfFields() {
+	for _, fields := range m.NonOneOfFields(payloadData) {
 		//checks skip_payload field option
 		if fieldSkip, err := m.GetFieldOptionPayload(fields); err != nil {
 			return "", err
@@ -807,12 +908,21 @@ func (m *jaalModule) PayloadType(payloadData pgs.Message, imports map[string]str
 
 		if strings.ToLower(fields.Name().String()) == "id" || idOption {
 
+			if m.IsProto3Optional(fields) {
+				return "", fmt.Errorf("%s: id can not be optional", fields.FullyQualifiedName())
+			}
+
 			msgArg += "schemabuilder.ID"
-			tVal += "schemabuilder."
-			tVal += strings.ToUpper(fields.Name().String())
-			tVal += "{Value: in."
-			tVal += fields.Name().UpperCamelCase().String()
-			tVal += "}"
+			if nodeTypeName != "" && !fields.Type().IsRepeated() {
+				// ids of nodes are exposed as global ids
+				tVal += "schemabuilder.ID{Value: encodeGlobalID(\"" + nodeTypeName + "\", in."
+				tVal += fields.Name().UpperCamelCase().String()
+				tVal += ")}"
+			} else {
+				tVal += "schemabuilder.ID{Value: in."
+				tVal += fields.Name().UpperCamelCase().String()
+				tVal += "}"
+			}
 
 		} else if fields.Type().IsRepeated() {
 
@@ -832,15 +942,14 @@ func (m *jaalModule) PayloadType(payloadData pgs.Message, imports map[string]str
 					msgArg += m.GetGoPackage(tObj.Embed().File())
 					msgArg += "."
 				} else {
-					if strings.Split(tObj.Embed().FullyQualifiedName(), ".")[len(strings.Split(tObj.Embed().FullyQualifiedName(), "."))-2] == tObj.Embed().Parent().Name().String() {
-						names := strings.Split(fields.FullyQualifiedName(), ".")
-						tembeddedMessageParent := strings.Join(names[1:len(names)-1], "_") + "_"
-						msgArg += tembeddedMessageParent
-					}
+					msgArg += m.nestedPrefix(tObj.Embed())
 				}
 
 			}
 
+			if tObj.IsEnum() {
+				msgArg += m.enumPackagePrefix(payloadData.File(), tObj.Enum())
+			}
 			ttype := m.fieldElementType(tObj)
 			msgArg += ttype
 			tVal += "in." + fields.Name().UpperCamelCase().String()
@@ -850,7 +959,25 @@ func (m *jaalModule) PayloadType(payloadData pgs.Message, imports map[string]str
 
 			tVal += "in."
 			tVal += fields.Name().UpperCamelCase().String()
-			maps = append(maps, PayloadMap{FieldName: fieldName, TargetVal: tVal})
+
+			if entries, err := m.IsMapEntries(fields); err != nil {
+				return "", err
+			} else if entries {
+				// maps are registered as a list of key value entries
+				funcPara, _, toList, err := m.mapEntryFuncs(fields, payloadData.File())
+				if err != nil {
+					return "", err
+				}
+				msg.Fields = append(msg.Fields, PayloadFields{FieldName: fieldName, FuncPara: funcPara, TargetVal: toList + "(" + tVal + ")"})
+				continue
+			}
+
+			payloadMap := PayloadMap{FieldName: fieldName, TargetVal: tVal}
+			if wrapper := m.MapWrapper(fields); wrapper != nil {
+				// wrapped values are exchanged as nullable scalars
+				payloadMap.Key, payloadMap.Value, payloadMap.Wrapper = m.fieldElementType(fields.Type().Key()), "*"+wrapper.ValueType, wrapper.GoType
+			}
+			maps = append(maps, payloadMap)
 			continue
 
 		} else if fields.Descriptor().GetType().String() == "TYPE_MESSAGE" {
@@ -863,11 +990,7 @@ func (m *jaalModule) PayloadType(payloadData pgs.Message, imports map[string]str
 					msgArg += goPkg
 					msgArg += "."
 				} else {
-					if strings.Split(fields.FullyQualifiedName(), ".")[len(strings.Split(fields.FullyQualifiedName(), "."))-2] == fields.Type().Embed().Parent().Name().String() {
-						names := strings.Split(fields.FullyQualifiedName(), ".")
-						tembeddedMessageParent := strings.Join(names[1:len(names)-1], "_") + "_"
-						msgArg += tembeddedMessageParent
-					}
+					msgArg += m.nestedPrefix(fields.Type().Embed())
 				}
 			}
 
@@ -883,8 +1006,7 @@ func (m *jaalModule) PayloadType(payloadData pgs.Message, imports map[string]str
 				goPkg += "."
 			}
 
-			tTypeArr := strings.Split(fields.Descriptor().GetTypeName(), ".")
-			msgArg = goPkg + tTypeArr[len(tTypeArr)-1]
+			msgArg = goPkg + m.Context.Name(fields.Type().Enum()).String()
 			tVal += "in."
 			tVal += fields.Name().UpperCamelCase().String()
 
@@ -899,26 +1021,34 @@ func (m *jaalModule) PayloadType(payloadData pgs.Message, imports map[string]str
 			tVal += fields.Name().UpperCamelCase().String()
 
 		}
-		if strings.HasSuffix(msgArg, "timestamp.Timestamp") {
-			// handles special case of timestamp
-			//todo repeated case is not handled
-			msgArg = msgArg[:len(msgArg)-19]
-			msgArg += "schemabuilder.Timestamp"
 
-			tVal = "(*schemabuilder.Timestamp)(" + tVal + ")"
-		} else if strings.HasSuffix(msgArg, "duration.Duration") {
-			if fields.Type().IsRepeated() {
-				msgArg = msgArg[:len(msgArg)-17]
-				msgArg += "schemabuilder.Duration"
-				msg.Durations = append(msg.Durations, Duration{FieldName: fields.Name().LowerCamelCase().String(), Name: fields.Name().UpperCamelCase().String()})
-				continue
-			} else {
-				msgArg = msgArg[:len(msgArg)-17]
-				msgArg += "schemabuilder.Duration"
-				tVal = "(*schemabuilder.Duration)(" + tVal + ")"
+		if m.IsPresenceField(fields) {
+			// optional fields are null when unset
+			msgArg = "*" + msgArg
+		}
+		if jsonType := m.FieldJSON(fields); jsonType != nil {
+			// free-form messages are registered as Map scalar
+			jsonField, err := m.jsonField(fields, fieldName, jsonType, false)
+			if err != nil {
+				return "", err
 			}
-		} else if strings.HasSuffix(msgArg, "field_mask.FieldMask") {
+			msg.JSONs = append(msg.JSONs, jsonField)
+			continue
+		}
+
+		if wrapper := m.FieldWrapper(fields); wrapper != nil {
+			// wrappers are registered as nullable scalars
+			funcPara, _, unwrap := m.wrapperFuncs(fields, wrapper)
+			msgArg = funcPara
+			tVal = unwrap + "(in." + fields.Name().UpperCamelCase().String() + ")"
+		} else if wkt := m.FieldWKT(fields); wkt != nil && wkt.Name == "FieldMask" {
+			msgArg = "*" + wkt.GoType
 			tVal = "gtypes.ModifyFieldMask(" + tVal + ")"
+		} else if wkt != nil {
+			// timestamps and durations are converted field wise
+			funcPara, _, fromProto := m.wktFuncs(fields, wkt)
+			msgArg = funcPara
+			tVal = fromProto + "(in." + fields.Name().UpperCamelCase().String() + ")"
 		} else if strings.HasSuffix(msgArg, "byte") {
 			msgArg = "*" + "schemabuilder.Bytes"
 			tVal = "&schemabuilder.Bytes{Value:in." + fields.Name().UpperCamelCase().String() + "}"
@@ -927,7 +1057,51 @@ func (m *jaalModule) PayloadType(payloadData pgs.Message, imports map[string]str
 			continue
 		}
 
-		msg.Fields = append(msg.Fields, PayloadFields{FieldName: fieldName, FuncPara: msgArg, TargetVal: tVal})
+		payloadField := PayloadFields{FieldName: fieldName, FuncPara: msgArg, TargetVal: tVal}
+		var nulls []string
+		if nullable, err := m.IsNullable(fields); err != nil {
+			return "", err
+		} else if nullable {
+			// the zero value is exposed as null
+			nulls = append(nulls, tVal+" == "+m.zeroValue(fields))
+		}
+
+		if fields.Type().IsRepeated() && fields.Type().Element().IsEnum() {
+			// hidden enum values are exposed as null elements, so the list keeps the positions of the values
+			hidden, err := m.hiddenValueConds(fields.Type().Element().Enum(), "v")
+			if err != nil {
+				return "", err
+			} else if len(hidden) != 0 {
+				payloadField.FuncPara = "[]*" + strings.TrimPrefix(msgArg, "[]")
+				payloadField.NullElem = strings.Join(hidden, " || ")
+			}
+		} else if fields.Type().IsEnum() && m.IsPresenceField(fields) {
+			// hidden enum values of optional fields are exposed as null as unset fields
+			hidden, err := m.hiddenValueConds(fields.Type().Enum(), "*"+tVal)
+			if err != nil {
+				return "", err
+			} else if len(hidden) != 0 {
+				payloadField.Null = tVal + " != nil && (" + strings.Join(hidden, " || ") + ")"
+			}
+		} else if fields.Type().IsEnum() {
+			// hidden enum values are exposed as null
+			hidden, err := m.hiddenValueConds(fields.Type().Enum(), tVal)
+			if err != nil {
+				return "", err
+			}
+			for _, cond := range hidden {
+				if len(nulls) == 0 || nulls[0] != cond {
+					nulls = append(nulls, cond)
+				}
+			}
+		}
+
+		if len(nulls) != 0 {
+			payloadField.FuncPara = "*" + msgArg
+			payloadField.TargetVal = "&" + tVal
+			payloadField.Null = strings.Join(nulls, " || ")
+		}
+		msg.Fields = append(msg.Fields, payloadField)
 
 	}
 
@@ -967,6 +1141,7 @@ type MapData struct {
 	Name       string
 	Key        string
 	Value      string
+	Wrapper    string
 	NewVarName string
 }
 
@@ -984,8 +1159,16 @@ type Query struct {
 	ReturnFunc         string
 	MapsData           []MapData
 	Oneofs             []OneOfMutation
-	Durations          []Duration
 	Ids                []Id
+	ZeroValue          string
+	NodeType           string
+	NodeIdArg          string
+	Connection         *Connection
+	JSONs              []JSONField
+	Required           []RequiredCheck
+	NullEnums          []string
+	Validate           bool
+	FullMethod         string
 }
 
 type Mutation struct {
@@ -997,6 +1180,9 @@ type Mutation struct {
 	ResponseType       string
 	ReturnType         string
 	OneOfs             []OneOfMutation
+	Required           []RequiredCheck
+	Validate           bool
+	FullMethod         string
 }
 
 type OneOfMutation struct {
@@ -1004,9 +1190,10 @@ type OneOfMutation struct {
 	Fields []Fields
 }
 type Service struct {
-	Name      string
-	Queries   []Query
-	Mutations []Mutation
+	Name          string
+	Queries       []Query
+	Mutations     []Mutation
+	Subscriptions []Query
 }
 
 func (m *jaalModule) InputAppend(str string) string {
@@ -1042,7 +1229,7 @@ func (m *jaalModule) getFieldNameOption(field pgs.Field) (bool, string, error) {
 		if err == proto.ErrMissingExtension {
 			return false, "", nil
 		}
-		return false, "", err
+		return false, "", fmt.Errorf("%s: %v", field.FullyQualifiedName(), err)
 	}
 
 	option := *x.(*string)
@@ -1063,7 +1250,7 @@ func (m *jaalModule) GetFieldOptionInput(field pgs.Field) (bool, error) {
 			return false, nil
 		}
 
-		return false, err
+		return false, fmt.Errorf("%s: %v", field.FullyQualifiedName(), err)
 	}
 
 	option := *x.(*bool)
@@ -1090,7 +1277,7 @@ func (m *jaalModule) GetFieldOptionPayload(field pgs.Field) (bool, error) {
 
 		}
 
-		return false, err
+		return false, fmt.Errorf("%s: %v", field.FullyQualifiedName(), err)
 
 	}
 
@@ -1119,7 +1306,7 @@ func (m *jaalModule) GetOption(rpc pgs.Method) (bool, pbt.MethodOptions, error)
 
 		}
 
-		return false, pbt.MethodOptions{}, err
+		return false, pbt.MethodOptions{}, fmt.Errorf("%s: %v", rpc.FullyQualifiedName(), err)
 
 	}
 
@@ -1128,31 +1315,62 @@ func (m *jaalModule) GetOption(rpc pgs.Method) (bool, pbt.MethodOptions, error)
 	return true, option, nil
 }
 
-func (m *jaalModule) ServiceInput(service pgs.Service) (string, error) {
-	// returns generated template(Service) in for a service type
+func (m *jaalModule) FullMethod(rpc pgs.Method) string {
+	// returns the full grpc method name of an rpc, e.g. /customer.Customers/GetCustomer
+
+	return "/" + strings.TrimPrefix(rpc.Service().FullyQualifiedName(), ".") + "/" + rpc.Name().String()
+}
+
+func (m *jaalModule) checkStreaming(rpc pgs.Method, option pbt.MethodOptions) error {
+	// queries and mutations are unary, subscriptions are served by server streaming rpcs only
+
+	if option.GetSubscription() != "" {
+		if rpc.ClientStreaming() || !rpc.ServerStreaming() {
+			return fmt.Errorf("%s: subscription can be used to tag server streaming rpcs only", rpc.FullyQualifiedName())
+		}
+		return nil
+	}
+
+	if rpc.ClientStreaming() || rpc.ServerStreaming() {
+		return fmt.Errorf("%s: streaming rpcs can be tagged as subscription only", rpc.FullyQualifiedName())
+	}
+
+	return nil
+}
+
+func (m *jaalModule) ServiceData(service pgs.Service) (*Service, error) {
+	// returns the queries, mutations and subscriptions registered for a service, with the go types of their arguments
 
 	var varQuery []Query
 	var varMutation []Mutation
+	var varSubscription []Query
 
 	for _, rpc := range service.Methods() {
 
 		flag, option, err := m.GetOption(rpc)
 
 		if err != nil {
-
-			m.Log("Error", err)
-			os.Exit(0)
-
+			return nil, err
 		}
 
 		if flag == false {
 			continue
 
 		}
+
+		if err := m.checkStreaming(rpc, option); err != nil {
+			return nil, err
+		}
+
 		//todo case for no query and mutation
 		if option.GetMutation() == "" {
 
 			fieldName := option.GetQuery()
+			zeroValue := ""
+			if option.GetSubscription() != "" {
+				fieldName = option.GetSubscription()
+				zeroValue = "nil"
+			}
 			firstReturnArgType := ""
 			if rpc.Output().Package().ProtoName().String() != service.Package().ProtoName().String() {
 				firstReturnArgType += m.GetGoPackage(rpc.Output().File())
@@ -1160,18 +1378,31 @@ func (m *jaalModule) ServiceInput(service pgs.Service) (string, error) {
 			}
 			firstReturnArgType += rpc.Output().Name().UpperCamelCase().String()
 			returnFunc := rpc.Name().UpperCamelCase().String()
+			nodeType, err := m.NodeTypeOfRPC(rpc)
+			if err != nil {
+				return nil, err
+			}
+			nodeIdArg := ""
+			connection, err := m.GetConnection(rpc, option)
+			if err != nil {
+				return nil, err
+			}
+			if connection != nil {
+				zeroValue = "nil"
+			}
 			var inType []Fields
 			var returnType []Fields
 			var mapsData []MapData
 			var oneOfs []OneOfMutation
-			var duration []Duration
 			var rIds []Id
-			for _, oneOf := range rpc.Input().OneOfs() {
+			var jsonArgs []JSONField
+			var nullEnums []string
+			for _, oneOf := range m.OneOfs(rpc.Input()) {
 				var fields []Fields
 				for _, field := range oneOf.Fields() {
 					//checks skip_input field option
 					if fieldSkip, err := m.GetFieldOptionInput(field); err != nil {
-						return "", err
+						return nil, err
 					} else if fieldSkip {
 						continue
 					}
@@ -1189,20 +1420,29 @@ func (m *jaalModule) ServiceInput(service pgs.Service) (string, error) {
 			for _, field := range rpc.Input().Fields() {
 				//checks skip_input field option
 				if fieldSkip, err := m.GetFieldOptionInput(field); err != nil {
-					return "", err
+					return nil, err
 				} else if fieldSkip {
 					continue
 				}
 				name := field.Name().UpperCamelCase().String()
+				if connection != nil && (name == connection.PageSize || name == connection.PageToken) {
+					// paging fields are set from first and after
+					continue
+				}
 				tType := ""
 
+				toMap := ""
 				idOption, err := m.IdOption(field)
 				if err != nil {
-					return "", err
+					return nil, err
 				}
 
 				if strings.ToLower(name) == "id" || idOption {
 
+					if m.IsProto3Optional(field) {
+						return nil, fmt.Errorf("%s: id can not be optional", field.FullyQualifiedName())
+					}
+
 					tType = "schemabuilder.ID"
 					if field.Type().IsRepeated() {
 						tType = "[]" + tType
@@ -1234,30 +1474,46 @@ func (m *jaalModule) ServiceInput(service pgs.Service) (string, error) {
 
 					}
 
-					tType += m.fieldElementType(tObj)
-					if strings.HasSuffix(tType, "duration.Duration") {
-						tType = tType[:len(tType)-17]
-						tType += "schemabuilder.Duration"
-						duration = append(duration, Duration{Name: field.Name().UpperCamelCase().String()})
+					if tObj.IsEnum() {
+						tType += m.enumPackagePrefix(service.File(), tObj.Enum())
 					}
+					tType += m.fieldElementType(tObj)
 
 				} else if field.Type().IsMap() {
-					tType = "*schemabuilder.Map"
-					goPkg := ""
-					if field.Type().Element().IsEmbed() {
-						goPkg = m.GetGoPackageOfFiles(service.File(), field.Type().Element().Embed().File())
-						if goPkg != "" {
-							goPkg += "."
-						}
+					entries, err := m.IsMapEntries(field)
+					if err != nil {
+						return nil, err
 					}
 
-					asterik := ""
-					if field.Type().Element().IsEmbed() {
-						asterik = "*"
-					}
+					if entries {
+						// maps are accepted as a list of key value entries
+						if tType, toMap, _, err = m.mapEntryFuncs(field, service.File()); err != nil {
+							return nil, err
+						}
+					} else {
+						tType = "*schemabuilder.Map"
+						goPkg := ""
+						if field.Type().Element().IsEmbed() {
+							goPkg = m.GetGoPackageOfFiles(service.File(), field.Type().Element().Embed().File())
+							if goPkg != "" {
+								goPkg += "."
+							}
+						} else if field.Type().Element().IsEnum() {
+							goPkg = m.enumPackagePrefix(service.File(), field.Type().Element().Enum())
+						}
 
-					value := asterik + goPkg + m.fieldElementType(field.Type().Element())
-					mapsData = append(mapsData, MapData{Name: name, NewVarName: field.Name().LowerCamelCase().String(), Key: m.fieldElementType(field.Type().Key()), Value: value})
+						asterik := ""
+						if field.Type().Element().IsEmbed() {
+							asterik = "*"
+						}
+
+						value := asterik + goPkg + m.fieldElementType(field.Type().Element())
+						wrapperType := ""
+						if wrapper := m.MapWrapper(field); wrapper != nil {
+							value, wrapperType = "*"+wrapper.ValueType, wrapper.GoType
+						}
+						mapsData = append(mapsData, MapData{Name: name, NewVarName: field.Name().LowerCamelCase().String(), Key: m.fieldElementType(field.Type().Key()), Value: value, Wrapper: wrapperType})
+					}
 					//returnType = "map returns"
 				} else if field.Type().IsEmbed() && field.Type().Embed().File().Descriptor().Options != nil && field.Type().Embed().File().Descriptor().Options.GoPackage != nil {
 					goPkg := m.GetGoPackageOfFiles(service.File(), field.Type().Embed().File())
@@ -1306,8 +1562,13 @@ func (m *jaalModule) ServiceInput(service pgs.Service) (string, error) {
 					}
 
 					tType += funcRType
+
+					if m.IsPresenceField(field) {
+						// optional arguments are nil when unset
+						tType = "*" + tType
+					}
 				}
-				if field.InOneOf() {
+				if m.InOneOf(field) {
 					tType = "*"
 					if field.Package().ProtoName().String() != service.Package().ProtoName().String() {
 						tType += m.GetGoPackage(field.File())
@@ -1317,23 +1578,47 @@ func (m *jaalModule) ServiceInput(service pgs.Service) (string, error) {
 					tType += "_"
 					tType += field.Name().UpperCamelCase().String()
 				} else if strings.ToLower(name) == "id" {
-					if tType != "[]schemabuilder.ID" {
+					if tType != "[]schemabuilder.ID" && nodeType != "" {
+						// the id of a node is taken as its global id
+						nodeIdArg = name
+						returnType = append(returnType, Fields{Name: name, Type: "localId"})
+					} else if tType != "[]schemabuilder.ID" {
 						returnType = append(returnType, Fields{Name: name, Type: "args.Id.Value"})
 					}
-				} else if tType == "*timestamp.Timestamp" {
-					tType = "*schemabuilder.Timestamp"
-					returnType = append(returnType, Fields{Name: name, Type: "(*timestamp.Timestamp)" + "(args." + name + ")"})
-				} else if tType == "*duration.Duration" {
-					tType = "*schemabuilder.Duration"
-					returnType = append(returnType, Fields{Name: name, Type: "(*duration.Duration)" + "(args." + name + ")"})
-				} else if tType == "*field_mask.FieldMask" {
+				} else if jsonType := m.FieldJSON(field); jsonType != nil {
+					// free-form messages are accepted as Map scalar
+					jsonArg, err := m.jsonField(field, name, jsonType, true)
+					if err != nil {
+						return nil, err
+					}
+					tType = jsonArg.Type
+					jsonArgs = append(jsonArgs, jsonArg)
+				} else if wrapper := m.FieldWrapper(field); wrapper != nil {
+					// wrappers are accepted as nullable scalars
+					funcPara, wrap, _ := m.wrapperFuncs(field, wrapper)
+					tType = funcPara
+					returnType = append(returnType, Fields{Name: name, Type: wrap + "(args." + name + ")"})
+				} else if wkt := m.FieldWKT(field); wkt != nil && wkt.Name == "FieldMask" {
 					returnType = append(returnType, Fields{Name: name, Type: "gtypes.ModifyFieldMask" + "(args." + name + ")"})
+				} else if wkt != nil {
+					// timestamps and durations are converted field wise
+					funcPara, toProto, _ := m.wktFuncs(field, wkt)
+					tType = funcPara
+					returnType = append(returnType, Fields{Name: name, Type: toProto + "(args." + name + ")"})
+				} else if toMap != "" {
+					returnType = append(returnType, Fields{Name: name, Type: toMap + "(args." + name + ")"})
 				} else if field.Type().IsMap() {
 					returnType = append(returnType, Fields{Name: name, Type: field.Name().LowerCamelCase().String() + "Map"})
 				} else if strings.HasSuffix(tType, "byte") {
 					tType = "*" + "schemabuilder.Bytes"
 					returnType = append(returnType, Fields{Name: name, Type: "args." + name + ".Value"})
-				} else if !strings.HasSuffix(tType, "schemabuilder.Duration") {
+				} else if hidesZero, err := m.HidesZero(field); err != nil {
+					return nil, err
+				} else if hidesZero {
+					// enums hiding their zero value are nil when omitted, the request keeps the zero value
+					tType = "*" + tType
+					nullEnums = append(nullEnums, name)
+				} else {
 					returnType = append(returnType, Fields{Name: name, Type: "args." + name})
 				}
 				inType = append(inType, Fields{Name: name, Type: tType})
@@ -1344,7 +1629,23 @@ func (m *jaalModule) ServiceInput(service pgs.Service) (string, error) {
 				inputName += "."
 			}
 			inputName += rpc.Input().Name().UpperCamelCase().String()
-			varQuery = append(varQuery, Query{Ids: rIds, Durations: duration, Oneofs: oneOfs, InputName: inputName, MapsData: mapsData, ReturnType: returnType, FieldName: fieldName, InType: inType, FirstReturnArgType: firstReturnArgType, ReturnFunc: returnFunc})
+			if zeroValue == "" {
+				zeroValue = firstReturnArgType + "{}"
+			}
+			var renames map[string]string
+			if connection != nil {
+				renames = map[string]string{connection.PageSize: "first", connection.PageToken: "after"}
+			}
+			required, err := m.RequiredChecks(rpc.Input(), "", renames)
+			if err != nil {
+				return nil, err
+			}
+			query := Query{Ids: rIds, Oneofs: oneOfs, InputName: inputName, MapsData: mapsData, ReturnType: returnType, FieldName: fieldName, InType: inType, FirstReturnArgType: firstReturnArgType, ReturnFunc: returnFunc, ZeroValue: zeroValue, NodeType: nodeType, NodeIdArg: nodeIdArg, Connection: connection, JSONs: jsonArgs, Required: required, NullEnums: nullEnums, Validate: m.HasValidateRules(rpc.Input()), FullMethod: m.FullMethod(rpc)}
+			if option.GetSubscription() != "" {
+				varSubscription = append(varSubscription, query)
+			} else {
+				varQuery = append(varQuery, query)
+			}
 
 		} else if option.GetQuery() == "" {
 
@@ -1360,7 +1661,7 @@ func (m *jaalModule) ServiceInput(service pgs.Service) (string, error) {
 			requestType := "&" + goPkg + rpc.Input().Name().UpperCamelCase().String()
 			var requestFields []string
 			var oneOfMutation []OneOfMutation
-			for _, oneOf := range rpc.Input().OneOfs() {
+			for _, oneOf := range m.OneOfs(rpc.Input()) {
 				var fields []Fields
 				for _, field := range oneOf.Fields() {
 					goPkg := m.GetGoPackageOfFiles(service.File(), field.File())
@@ -1374,20 +1675,34 @@ func (m *jaalModule) ServiceInput(service pgs.Service) (string, error) {
 				}
 				oneOfMutation = append(oneOfMutation, OneOfMutation{Name: oneOf.Name().UpperCamelCase().String(), Fields: fields})
 			}
-			for _, fields := range rpc.Input().NonOneOfFields() {
+			for _, fields := range m.NonOneOfFields(rpc.Input()) {
 
 				requestFields = append(requestFields, fields.Name().UpperCamelCase().String())
 
 			}
 
 			responseType := rpc.Name().UpperCamelCase().String()
-			varMutation = append(varMutation, Mutation{OneOfs: oneOfMutation, FieldName: fieldName, InputType: inputType, FirstReturnArgType: firstReturnArgType, RequestType: requestType, RequestFields: requestFields, ResponseType: responseType, ReturnType: returnType})
+			required, err := m.RequiredChecks(rpc.Input(), "input", nil)
+			if err != nil {
+				return nil, err
+			}
+			varMutation = append(varMutation, Mutation{OneOfs: oneOfMutation, FieldName: fieldName, InputType: inputType, FirstReturnArgType: firstReturnArgType, RequestType: requestType, RequestFields: requestFields, ResponseType: responseType, ReturnType: returnType, Required: required, Validate: m.HasValidateRules(rpc.Input()), FullMethod: m.FullMethod(rpc)})
 
 		}
 	}
 
 	name := service.Name().UpperCamelCase().String()
-	varService := Service{Name: name, Queries: varQuery, Mutations: varMutation}
+	return &Service{Name: name, Queries: varQuery, Mutations: varMutation, Subscriptions: varSubscription}, nil
+}
+
+func (m *jaalModule) ServiceInput(service pgs.Service) (string, error) {
+	// returns generated template(Service) in for a service type
+
+	varService, err := m.ServiceData(service)
+	if err != nil {
+		return "", err
+	}
+
 	tmp := getServiceTemplate()
 	buf := &bytes.Buffer{}
 
@@ -1439,16 +1754,11 @@ func (m *jaalModule) RPCFieldType(field pgs.Field) string {
 
 		if field.Type().IsRepeated() {
 
-			tTypeArr := strings.Split(*field.Descriptor().TypeName, ".")
-			typeName := tTypeArr[len(tTypeArr)-1]
-
-			return typeName
+			return m.Context.Name(field.Type().Element().Enum()).String()
 
 		}
 
-		enum := field.Type().Enum()
-
-		return enum.Name().String()
+		return m.Context.Name(field.Type().Enum()).String()
 
 	default:
 
@@ -1470,10 +1780,7 @@ func (m *jaalModule) ServiceStructInput(service pgs.Service) (string, error) {
 		flag, option, err := m.GetOption(rpc)
 
 		if err != nil {
-
-			m.Log("Error", err)
-			os.Exit(0)
-
+			return "", err
 		}
 
 		if flag == false {
@@ -1518,6 +1825,9 @@ func (m *jaalModule) ServiceStructInput(service pgs.Service) (string, error) {
 					}
 				}
 
+				if tObj.IsEnum() {
+					ttype += m.enumPackagePrefix(service.File(), tObj.Enum())
+				}
 				ttype += m.fieldElementType(tObj)
 			} else if ipField.Type().IsMap() {
 				goPkg := ""
@@ -1527,6 +1837,8 @@ func (m *jaalModule) ServiceStructInput(service pgs.Service) (string, error) {
 					if goPkg != "" {
 						goPkg += "."
 					}
+				} else if ipField.Type().Element().IsEnum() {
+					goPkg = m.enumPackagePrefix(service.File(), ipField.Type().Element().Enum())
 				}
 
 				asterik := ""
@@ -1565,8 +1877,12 @@ func (m *jaalModule) ServiceStructInput(service pgs.Service) (string, error) {
 					ttype = goPkg + ttype
 
 				}
+
+				if m.IsPresenceField(ipField) {
+					ttype = "*" + ttype
+				}
 			}
-			if ipField.InOneOf() {
+			if m.InOneOf(ipField) {
 				// handles one of fields
 				goPkg := m.GetGoPackageOfFiles(service.File(), ipField.OneOf().File())
 				if goPkg != "" {
@@ -1621,10 +1937,7 @@ func (m *jaalModule) ServiceStructPayload(service pgs.Service) (string, error) {
 		flag, option, err := m.GetOption(rpc)
 
 		if err != nil {
-
-			m.Log("Error", err)
-			os.Exit(0)
-
+			return "", err
 		}
 
 		if flag == false {
@@ -1666,8 +1979,7 @@ func (m *jaalModule) getPossibleReqObjects(service pgs.Service, PossibleReqObjec
 	for _, rpc := range service.Methods() {
 		flag, option, err := m.GetOption(rpc)
 		if err != nil {
-			m.Log("Error", err)
-			os.Exit(0)
+			return err
 		}
 
 		if flag == false {
@@ -1686,13 +1998,25 @@ func (m *jaalModule) getPossibleReqObjects(service pgs.Service, PossibleReqObjec
 	return nil
 }
 
-func (m *jaalModule) InitFunc(initFunctionsName map[string]bool) (string, error) {
-	//returns template of init function
+type InitData struct {
+	Name  string
+	File  string
+	Init  bool
+	Funcs map[string]bool
+}
+
+func (m *jaalModule) InitFunc(target pgs.File, initFunctionsName map[string]bool) (string, error) {
+	//returns template of the registration function of a file, called from init unless init parameter is false
+
+	init, err := m.InitOption()
+	if err != nil {
+		return "", err
+	}
 
 	tmp := getInitTemplate()
 	buf := &bytes.Buffer{}
 
-	if err := tmp.Execute(buf, initFunctionsName); err != nil {
+	if err := tmp.Execute(buf, InitData{Name: m.FileTypesFunc(target), File: target.Name().String(), Init: init, Funcs: initFunctionsName}); err != nil {
 		return "", err
 	}
 
@@ -1711,19 +2035,70 @@ func (m *jaalModule) TypeCastType(typeCastMap map[string]string) (string, error)
 func (m *jaalModule) GetGoPackage(target pgs.File) string {
 	//returns go package for a file
 
-	goPackage := "pb"
+	// the package name after ";" is used when present, e.g. structpb for "github.com/golang/protobuf/ptypes/struct;structpb"
+	_, goPackage := m.GoImport(target, "pb")
+
+	return goPackage
+}
+func (m *jaalModule) GoImport(target pgs.File, fallback string) (string, string) {
+	/*
+		returns import path and package name of the go package of a file
+		fallback is used for files without go_package, e.g. "github.com/golang/protobuf/ptypes/struct;structpb"
+		the name after ";" is used when present, else the last element of the path, the one before the major version
+		of a module path, e.g. foo for "example.com/foo/v2"
+	*/
 
+	goPackage := fallback
 	if target.Descriptor().GetOptions() != nil && target.Descriptor().GetOptions().GoPackage != nil {
 		goPackage = *target.Descriptor().GetOptions().GoPackage
-		goPackage = strings.Split(goPackage, ";")[0]
-		goPackage = strings.Split(goPackage, "/")[len(strings.Split(goPackage, "/"))-1]
+	}
 
+	importPath, name := goPackage, ""
+	if i := strings.Index(goPackage, ";"); i != -1 {
+		importPath, name = goPackage[:i], goPackage[i+1:]
+	}
+	if name == "" {
+		elements := strings.Split(importPath, "/")
+		name = elements[len(elements)-1]
+		if len(elements) > 1 && majorVersionRegexp.MatchString(name) {
+			name = elements[len(elements)-2]
+		}
 	}
 
-	return goPackage
+	return importPath, goPackageName(name)
+}
+
+// majorVersionRegexp matches the major version suffix of a module path, e.g. v2.
+var majorVersionRegexp = regexp.MustCompile(`^v[0-9]+$`)
+
+func goPackageName(name string) string {
+	// returns name as a go identifier, the characters not allowed in identifiers are replaced by _, e.g. foo_bar for foo-bar
+
+	name = strings.Map(func(r rune) rune {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			return r
+		}
+		return '_'
+	}, name)
+	if r, _ := utf8.DecodeRuneInString(name); unicode.IsDigit(r) {
+		name = "_" + name
+	}
+
+	return name
+}
+
+func goImportAlias(importPath, name string) string {
+	// returns the name an import is given in the generated code, empty when the package is named after the last element of its path
+
+	if name == path.Base(importPath) {
+		return ""
+	}
+
+	return name
 }
+
 func (m *jaalModule) GetImports(target pgs.File) map[string]string {
-	// returns a map of all imports
+	// returns the import paths of the go packages of the files imported by target, along with their names
 
 	imports := make(map[string]string)
 
@@ -1731,9 +2106,8 @@ func (m *jaalModule) GetImports(target pgs.File) map[string]string {
 
 		if importFile.Descriptor().Options != nil && importFile.Descriptor().Options.GoPackage != nil {
 
-			key := *importFile.Descriptor().Options.GoPackage
-			key = strings.Split(key, ";")[0]
-			imports[key] = strings.Split(key, "/")[len(strings.Split(key, "/"))-1]
+			importPath, name := m.GoImport(importFile, "")
+			imports[importPath] = name
 		}
 	}
 
@@ -1747,13 +2121,12 @@ func (m *jaalModule) ServiceStructInputFunc(service pgs.Service, initFunctionsNa
 
 	for _, rpc := range service.Methods() {
 		var maps []InputMap
-		var durations []Duration
 		var rIds []Id
+		var jsons []JSONField
 		flag, option, err := m.GetOption(rpc)
 
 		if err != nil {
-			m.Log("Error", err)
-			os.Exit(0)
+			return "", err
 		}
 
 		if flag == false {
@@ -1765,6 +2138,12 @@ func (m *jaalModule) ServiceStructInputFunc(service pgs.Service, initFunctionsNa
 		}
 
 		var field []MsgFields
+		var nodeIds []NodeId
+
+		nodeType, err := m.NodeTypeOfRPC(rpc)
+		if err != nil {
+			return "", err
+		}
 
 		for _, ipField := range rpc.Input().Fields() {
 			//checks skip_input field option
@@ -1785,6 +2164,14 @@ func (m *jaalModule) ServiceStructInputFunc(service pgs.Service, initFunctionsNa
 			}
 
 			if strings.ToLower(fName) == "id" || idOption {
+				if m.IsProto3Optional(ipField) {
+					return "", fmt.Errorf("%s: id can not be optional", ipField.FullyQualifiedName())
+				}
+				if nodeType != "" && strings.ToLower(fName) == "id" && !ipField.Type().IsRepeated() {
+					// the id of a node is taken as its global id
+					nodeIds = append(nodeIds, NodeId{FieldName: fName, Name: tname, TypeName: nodeType})
+					continue
+				}
 				funcPara = "*schemabuilder.ID"
 				tval = "source.Value"
 				if ipField.Type().IsRepeated() {
@@ -1792,7 +2179,7 @@ func (m *jaalModule) ServiceStructInputFunc(service pgs.Service, initFunctionsNa
 					rIds = append(rIds, Id{Name: ipField.Name().UpperCamelCase().String(), FieldName: ipField.Name().LowerCamelCase().String()})
 					continue
 				}
-			} else if ipField.InOneOf() {
+			} else if m.InOneOf(ipField) {
 				goPkg := m.GetGoPackageOfFiles(service.File(), ipField.OneOf().File())
 				if goPkg != "" {
 					goPkg += "."
@@ -1825,9 +2212,24 @@ func (m *jaalModule) ServiceStructInputFunc(service pgs.Service, initFunctionsNa
 
 				}
 				tval = "source"
+				if tObj.IsEnum() {
+					funcPara += m.enumPackagePrefix(service.File(), tObj.Enum())
+				}
 				funcPara += m.fieldElementType(tObj)
 			} else if ipField.Type().IsMap() {
 				// TODO : Repeated case not handled
+				if entries, err := m.IsMapEntries(ipField); err != nil {
+					return "", err
+				} else if entries {
+					// maps are accepted as a list of key value entries
+					funcPara, toMap, _, err := m.mapEntryFuncs(ipField, service.File())
+					if err != nil {
+						return "", err
+					}
+					field = append(field, MsgFields{TargetName: tname, FieldName: fName, FuncPara: funcPara, TargetVal: toMap + "(source)"})
+					continue
+				}
+
 				goPkg := ""
 				if ipField.Type().Element().IsEmbed() {
 
@@ -1835,6 +2237,8 @@ func (m *jaalModule) ServiceStructInputFunc(service pgs.Service, initFunctionsNa
 					if goPkg != "" {
 						goPkg += "."
 					}
+				} else if ipField.Type().Element().IsEnum() {
+					goPkg = m.enumPackagePrefix(service.File(), ipField.Type().Element().Enum())
 				}
 
 				asterik := ""
@@ -1843,7 +2247,11 @@ func (m *jaalModule) ServiceStructInputFunc(service pgs.Service, initFunctionsNa
 				}
 
 				value := asterik + goPkg + m.fieldElementType(ipField.Type().Element())
-				maps = append(maps, InputMap{FieldName: fName, TargetVal: "*source", TargetName: ipField.Name().UpperCamelCase().String(), Key: m.fieldElementType(ipField.Type().Key()), Value: value})
+				wrapperType := ""
+				if wrapper := m.MapWrapper(ipField); wrapper != nil {
+					value, wrapperType = "*"+wrapper.ValueType, wrapper.GoType
+				}
+				maps = append(maps, InputMap{FieldName: fName, TargetVal: "*source", TargetName: ipField.Name().UpperCamelCase().String(), Key: m.fieldElementType(ipField.Type().Key()), Value: value, Wrapper: wrapperType})
 				continue
 			} else {
 				var scalar = false
@@ -1879,32 +2287,46 @@ func (m *jaalModule) ServiceStructInputFunc(service pgs.Service, initFunctionsNa
 
 				if !scalar {
 					funcPara = "*" + goPkg + funcPara
+				} else if m.IsPresenceField(ipField) {
+					funcPara = "*" + goPkg + funcPara
+				} else {
+					funcPara = goPkg + funcPara
 				}
 			}
-			if strings.HasSuffix(funcPara, "*timestamp.Timestamp") {
-				funcPara = funcPara[:len(funcPara)-19] + "schemabuilder.Timestamp"
-				tval = "(*timestamp.Timestamp)(source)"
-			} else if strings.HasSuffix(funcPara, "duration.Duration") {
-				if ipField.Type().IsRepeated() {
-					durations = append(durations, Duration{Name: ipField.Name().UpperCamelCase().String()})
-					continue
-				} else {
-					funcPara = funcPara[:len(funcPara)-17]
-					funcPara += "schemabuilder.Duration"
-					tval = "(*duration.Duration)(" + tval + ")"
+			if jsonType := m.FieldJSON(ipField); jsonType != nil {
+				jsonField, err := m.jsonField(ipField, fName, jsonType, true)
+				if err != nil {
+					return "", err
 				}
-
-			} else if strings.HasSuffix(funcPara, "*field_mask.FieldMask") {
+				jsons = append(jsons, jsonField)
+				continue
+			} else if wrapper := m.FieldWrapper(ipField); wrapper != nil {
+				var wrap string
+				funcPara, wrap, _ = m.wrapperFuncs(ipField, wrapper)
+				tval = wrap + "(source)"
+			} else if wkt := m.FieldWKT(ipField); wkt != nil && wkt.Name == "FieldMask" {
 				tval = "gtypes.ModifyFieldMask(source)"
+			} else if wkt != nil {
+				var toProto string
+				funcPara, toProto, _ = m.wktFuncs(ipField, wkt)
+				tval = toProto + "(source)"
 			} else if strings.HasSuffix(funcPara, "byte") {
 				funcPara = "*schemabuilder.Bytes"
 				tval = "source.Value"
 			}
-			field = append(field, MsgFields{TargetName: tname, FieldName: fName, FuncPara: funcPara, TargetVal: tval})
+			inputField := MsgFields{TargetName: tname, FieldName: fName, FuncPara: funcPara, TargetVal: tval}
+			if hidesZero, err := m.HidesZero(ipField); err != nil {
+				return "", err
+			} else if hidesZero {
+				// null is accepted for the hidden zero value
+				inputField.FuncPara = "*" + funcPara
+				inputField.Nullable = true
+			}
+			field = append(field, inputField)
 		}
 
 		initFunctionsName["RegisterInput"+rpc.Name().UpperCamelCase().String()+"Input"] = true
-		inputServiceStructFunc = append(inputServiceStructFunc, InputClass{Name: rpc.Name().UpperCamelCase().String(), Fields: field, Maps: maps, Durations: durations, Ids: rIds})
+		inputServiceStructFunc = append(inputServiceStructFunc, InputClass{Name: rpc.Name().UpperCamelCase().String(), Fields: field, Maps: maps, Ids: rIds, NodeIds: nodeIds, JSONs: jsons})
 	}
 
 	tmp := getServiceStructInputFuncTemplate()
@@ -1924,10 +2346,7 @@ func (m *jaalModule) ServiceStructPayloadFunc(service pgs.Service, initFunctions
 	for _, rpc := range service.Methods() {
 		flag, option, err := m.GetOption(rpc)
 		if err != nil {
-
-			m.Log("Error", err)
-			os.Exit(0)
-
+			return "", err
 		}
 
 		if flag == false {
@@ -1963,11 +2382,13 @@ func (m *jaalModule) IdOption(field pgs.Field) (bool, error) {
 		x, err := proto.GetExtension(opt, pbt.E_Id)
 		if err == proto.ErrMissingExtension {
 			return false, nil
+		} else if err != nil {
+			return false, fmt.Errorf("%s: %v", field.FullyQualifiedName(), err)
 		}
 
 		option := *x.((*bool))
 		if option == true && *field.Descriptor().Type != pgd.FieldDescriptorProto_TYPE_STRING {
-			return false, fmt.Errorf("id can be used to tag string fields only")
+			return false, fmt.Errorf("%s: id can be used to tag string fields only", field.FullyQualifiedName())
 		}
 
 		return option, nil
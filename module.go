@@ -2,6 +2,9 @@ package main
 
 import (
 	"fmt"
+	"path"
+	"sort"
+	"strings"
 
 	"github.com/golang/protobuf/proto"
 	pgs "github.com/lyft/protoc-gen-star"
@@ -26,7 +29,7 @@ func (m *jaalModule) CheckSkipFile(target pgs.File) (bool, error) {
 	if opt != nil {
 		x, err := proto.GetExtension(opt, pbt.E_FileSkip)
 		if err != nil && proto.ErrMissingExtension != err {
-			return false, err
+			return false, fmt.Errorf("file_skip option: %v", err)
 		}
 
 		if x != nil && *x.(*bool) == true { // skips only when file_skip is explicitly true
@@ -35,11 +38,54 @@ func (m *jaalModule) CheckSkipFile(target pgs.File) (bool, error) {
 	}
 	return false, nil
 }
+func (m *jaalModule) CheckParameters() error {
+	// checks the plugin parameters before anything is generated
+
+	if _, err := m.Parameters().Bool("sdl"); err != nil {
+		return fmt.Errorf("sdl parameter: %v", err)
+	}
+	if _, err := m.Parameters().Bool("strip_enum_prefix"); err != nil {
+		return fmt.Errorf("strip_enum_prefix parameter: %v", err)
+	}
+	if _, err := m.Parameters().Bool("skip_enum_zero"); err != nil {
+		return fmt.Errorf("skip_enum_zero parameter: %v", err)
+	}
+	if _, err := m.InitOption(); err != nil {
+		return fmt.Errorf("init parameter: %v", err)
+	}
+	if _, err := m.AnyStrategy(); err != nil {
+		return err
+	}
+	if _, err := m.MapStrategy(); err != nil {
+		return err
+	}
+	if _, _, err := m.OperationsOption(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 func (m *jaalModule) Execute(targets map[string]pgs.File, pkgs map[string]pgs.Package) []pgs.Artifact {
+	m.CheckErr(m.CheckParameters(), "invalid parameters")
+
+	// names and options are validated across all the targets before generating anything
+	if diagnostics := m.Validate(targets); len(diagnostics) != 0 {
+		m.Fail(strings.Join(diagnostics, "\n"))
+	}
+
+	// errors of all the files are reported together, nothing is generated if there is any
+	var errs []string
+
+	// sdl parameter also generates the graphql schema of each file
+	sdl, _ := m.Parameters().Bool("sdl")
+	// operations parameter also generates the graphql operations of the rpcs of each file
+	operations, depth, _ := m.OperationsOption()
+
 	for _, target := range targets { // loop over files
 
 		if ok, err := m.CheckSkipFile(target); err != nil { // checks file_skip option
-			fmt.Println("Error :", err)
+			errs = append(errs, fmt.Sprintf("%s: %v", target.Name(), err))
 			continue
 		} else if ok == true {
 			continue
@@ -52,9 +98,73 @@ func (m *jaalModule) Execute(targets map[string]pgs.File, pkgs map[string]pgs.Pa
 
 		str, err := m.generateFileData(target)
 		if err != nil {
-			m.Log("Error : ", err)
+			errs = append(errs, fmt.Sprintf("%s: %v", target.Name(), err))
+			continue
 		}
 		m.AddGeneratorFile(name, str)
+
+		if sdl {
+			str, err := m.generateSDL(target)
+			if err != nil {
+				errs = append(errs, fmt.Sprintf("%s: %v", target.Name(), err))
+				continue
+			}
+			m.AddGeneratorFile(m.BuildContext.OutputPath()+"/"+fname+".graphql", str)
+		}
+
+		if operations {
+			str, err := m.generateOperations(target, depth)
+			if err != nil {
+				errs = append(errs, fmt.Sprintf("%s: %v", target.Name(), err))
+				continue
+			} else if str != "" {
+				m.AddGeneratorFile(m.BuildContext.OutputPath()+"/"+fname+".operations.graphql", str)
+			}
+		}
 	}
+
+	for _, pkg := range pkgs { // loop over packages
+		var files []pgs.File
+		for _, file := range pkg.Files() {
+			if _, ok := targets[file.Name().String()]; !ok {
+				continue
+			}
+
+			if ok, err := m.CheckSkipFile(file); err != nil || ok {
+				// errors are already reported with the file
+				continue
+			}
+
+			files = append(files, file)
+		}
+
+		if len(files) == 0 {
+			continue
+		}
+
+		dir := m.BuildContext.OutputPath() + "/" + path.Dir(files[0].Name().String())
+
+		str, err := m.generatePackageData(files)
+		if err != nil {
+			errs = append(errs, fmt.Sprintf("package %s: %v", pkg.ProtoName(), err))
+		} else if str != "" {
+			m.AddGeneratorFile(dir+"/jaal.pb.gq.go", str)
+		}
+
+		if sdl {
+			str, err := m.generatePackageSDL(files)
+			if err != nil {
+				errs = append(errs, fmt.Sprintf("package %s: %v", pkg.ProtoName(), err))
+			} else if str != "" {
+				m.AddGeneratorFile(dir+"/jaal.graphql", str)
+			}
+		}
+	}
+
+	if len(errs) != 0 {
+		sort.Strings(errs)
+		m.Fail(strings.Join(errs, "\n"))
+	}
+
 	return m.Artifacts()
 }
@@ -0,0 +1,213 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/golang/protobuf/proto"
+	pgs "github.com/lyft/protoc-gen-star"
+	pbt "go.appointy.com/protoc-gen-jaal/schema"
+)
+
+type Node struct {
+	Name        string
+	TypeName    string
+	Client      string
+	Method      string
+	RequestType string
+	IdField     string
+	FullMethod  string
+}
+
+type NodeClient struct {
+	Name string
+	Type string
+}
+
+type NodeId struct {
+	FieldName string
+	Name      string
+	TypeName  string
+}
+
+func (m *jaalModule) GetNodeOption(message pgs.Message) (bool, string, error) {
+	//returns node flag and get_rpc of a message
+
+	opt := message.Descriptor().GetOptions()
+	if opt == nil {
+		return false, "", nil
+	}
+
+	x, err := proto.GetExtension(opt, pbt.E_Node)
+	if err != nil {
+		if err == proto.ErrMissingExtension {
+			return false, "", nil
+		}
+		return false, "", fmt.Errorf("%s: %v", message.FullyQualifiedName(), err)
+	}
+
+	if !*x.(*bool) {
+		return false, "", nil
+	}
+
+	y, err := proto.GetExtension(opt, pbt.E_GetRpc)
+	if err != nil {
+		if err == proto.ErrMissingExtension {
+			return false, "", fmt.Errorf("%s: get_rpc is required on a node", message.FullyQualifiedName())
+		}
+		return false, "", fmt.Errorf("%s: %v", message.FullyQualifiedName(), err)
+	}
+
+	return true, *y.(*string), nil
+}
+
+func (m *jaalModule) nodeIdField(message pgs.Message) (pgs.Field, error) {
+	// returns the field of a message exposed as graphql ID
+
+	for _, field := range m.NonOneOfFields(message) {
+		idOption, err := m.IdOption(field)
+		if err != nil {
+			return nil, err
+		}
+
+		if (strings.ToLower(field.Name().String()) == "id" || idOption) && !field.Type().IsRepeated() {
+			return field, nil
+		}
+	}
+
+	return nil, fmt.Errorf("%s: node must have an id field", message.FullyQualifiedName())
+}
+
+func (m *jaalModule) nodeGetRPC(message pgs.Message, getRPC string) (pgs.Method, error) {
+	/*
+		returns the rpc referred by get_rpc of a node
+		rpc is looked up in the services of the package of node
+	*/
+	serviceName, methodName := "", getRPC
+	if i := strings.LastIndex(getRPC, "."); i != -1 {
+		serviceName, methodName = getRPC[:i], getRPC[i+1:]
+	}
+
+	for _, file := range message.Package().Files() {
+		for _, service := range file.Services() {
+			if serviceName != "" && service.Name().String() != serviceName {
+				continue
+			}
+
+			for _, rpc := range service.Methods() {
+				if rpc.Name().String() != methodName {
+					continue
+				}
+
+				if rpc.ClientStreaming() || rpc.ServerStreaming() {
+					return nil, fmt.Errorf("%s: get_rpc %s must be unary", message.FullyQualifiedName(), getRPC)
+				}
+
+				if rpc.Output().FullyQualifiedName() != message.FullyQualifiedName() {
+					return nil, fmt.Errorf("%s: get_rpc %s must return %s", message.FullyQualifiedName(), getRPC, message.Name())
+				}
+
+				return rpc, nil
+			}
+		}
+	}
+
+	return nil, fmt.Errorf("%s: get_rpc %s not found in package %s", message.FullyQualifiedName(), getRPC, message.Package().ProtoName())
+}
+
+func (m *jaalModule) NodeTypeName(message pgs.Message) (string, error) {
+	// returns the type name encoded in global ids of a message, empty if message is not a node
+
+	if ok, _, err := m.GetNodeOption(message); err != nil {
+		return "", err
+	} else if !ok {
+		return "", nil
+	}
+
+	return m.PayloadObjectName(message)
+}
+
+func (m *jaalModule) NodeTypeOfRPC(rpc pgs.Method) (string, error) {
+	/*
+		returns the type name of the node whose global id is taken as the id of the request of rpc, empty if there is none
+		it is the node fetched by a get_rpc, else the node returned by rpc
+	*/
+
+	for _, file := range rpc.Package().Files() {
+		for _, message := range file.AllMessages() {
+			ok, getRPC, err := m.GetNodeOption(message)
+			if err != nil {
+				return "", err
+			} else if !ok {
+				continue
+			}
+
+			nodeRPC, err := m.nodeGetRPC(message, getRPC)
+			if err != nil {
+				return "", err
+			}
+
+			if nodeRPC.FullyQualifiedName() == rpc.FullyQualifiedName() {
+				return m.PayloadObjectName(message)
+			}
+		}
+	}
+
+	return m.NodeTypeName(rpc.Output())
+}
+
+func (m *jaalModule) PackageNodes(files []pgs.File) ([]Node, []NodeClient, error) {
+	// returns all nodes of the files of a package and the clients required to fetch them
+
+	var nodes []Node
+	var clients []NodeClient
+	clientAdded := make(map[string]bool)
+
+	for _, file := range files {
+		for _, message := range file.AllMessages() {
+			ok, getRPC, err := m.GetNodeOption(message)
+			if err != nil {
+				return nil, nil, err
+			} else if !ok {
+				continue
+			}
+
+			rpc, err := m.nodeGetRPC(message, getRPC)
+			if err != nil {
+				return nil, nil, err
+			}
+
+			idField, err := m.nodeIdField(rpc.Input())
+			if err != nil {
+				return nil, nil, err
+			}
+
+			if _, err := m.nodeIdField(message); err != nil {
+				return nil, nil, err
+			}
+
+			typeName, err := m.PayloadObjectName(message)
+			if err != nil {
+				return nil, nil, err
+			}
+
+			client := rpc.Service().Name().LowerCamelCase().String() + "Client"
+			if !clientAdded[client] {
+				clientAdded[client] = true
+				clients = append(clients, NodeClient{Name: client, Type: rpc.Service().Name().UpperCamelCase().String() + "Client"})
+			}
+
+			nodes = append(nodes, Node{
+				Name:        m.Context.Name(message).String(),
+				TypeName:    typeName,
+				Client:      client,
+				Method:      rpc.Name().UpperCamelCase().String(),
+				RequestType: m.Context.Name(rpc.Input()).String(),
+				IdField:     idField.Name().UpperCamelCase().String(),
+				FullMethod:  m.FullMethod(rpc),
+			})
+		}
+	}
+
+	return nodes, clients, nil
+}
@@ -0,0 +1,307 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+
+	pgs "github.com/lyft/protoc-gen-star"
+)
+
+type Selection struct {
+	Name       string
+	Selections []Selection
+}
+
+type Operation struct {
+	Kind        string
+	Name        string
+	Description string
+	Variables   string
+	Field       string
+	Args        string
+	Selection   string
+}
+
+func (m *jaalModule) OperationsOption() (bool, int, error) {
+	// returns operations parameter and the depth of the selection sets, 3 by default
+
+	operations, err := m.Parameters().BoolDefault("operations", false)
+	if err != nil {
+		return false, 0, fmt.Errorf("operations parameter: %v", err)
+	}
+
+	depth, err := m.Parameters().IntDefault("operations_depth", 3)
+	if err != nil {
+		return false, 0, fmt.Errorf("operations_depth parameter: %v", err)
+	} else if depth < 1 {
+		return false, 0, fmt.Errorf("operations_depth parameter: %d, expected a positive depth", depth)
+	}
+
+	return operations, depth, nil
+}
+
+func (m *jaalModule) objectSelection(message pgs.Message, depth int) ([]Selection, error) {
+	/*
+		returns the default selection set of the payload object of a message
+		every scalar field is selected, objects are selected until depth is exhausted
+	*/
+
+	if skip, err := m.GetSkipOption(message); err != nil {
+		return nil, err
+	} else if skip {
+		return nil, nil
+	}
+
+	var selections []Selection
+	for _, oneof := range m.OneOfs(message) {
+		// the union of a oneof is selected with a fragment per member
+		selection := Selection{Name: oneof.Name().LowerCamelCase().String(), Selections: []Selection{{Name: "__typename"}}}
+		for _, field := range oneof.Fields() {
+			if fieldSkip, err := m.GetFieldOptionPayload(field); err != nil {
+				return nil, err
+			} else if fieldSkip {
+				continue
+			}
+
+			member, ok, err := m.fieldSelection(field, field.Name().LowerCamelCase().String(), depth)
+			if err != nil {
+				return nil, err
+			} else if !ok {
+				continue
+			}
+
+			name := field.Message().Name().UpperCamelCase().String() + "_" + field.Name().UpperCamelCase().String()
+			selection.Selections = append(selection.Selections, Selection{Name: "... on " + name, Selections: []Selection{member}})
+		}
+		selections = append(selections, selection)
+	}
+
+	for _, field := range m.NonOneOfFields(message) {
+		if fieldSkip, err := m.GetFieldOptionPayload(field); err != nil {
+			return nil, err
+		} else if fieldSkip {
+			continue
+		}
+
+		overrideFieldName, fieldName, err := m.getFieldNameOption(field)
+		if err != nil {
+			return nil, err
+		} else if !overrideFieldName {
+			fieldName = field.Name().LowerCamelCase().String()
+		}
+
+		selection, ok, err := m.fieldSelection(field, fieldName, depth)
+		if err != nil {
+			return nil, err
+		} else if ok {
+			selections = append(selections, selection)
+		}
+	}
+
+	return selections, nil
+}
+
+func (m *jaalModule) valueSelection(message pgs.Message, name string, depth int) (Selection, bool, error) {
+	// returns the selection of a field of the type of message, false if it is an object beyond depth or without fields
+
+	typeName, err := m.sdlMessageType(message, false, nil)
+	if err != nil {
+		return Selection{}, false, err
+	} else if typeName == "AnyUnion" {
+		return Selection{Name: name, Selections: []Selection{{Name: "__typename"}}}, true, nil
+	} else if m.JSONOf(message) != nil || m.WrapperOf(message) != nil || customScalars[typeName] {
+		return Selection{Name: name}, true, nil
+	}
+
+	if depth <= 1 {
+		return Selection{}, false, nil
+	}
+
+	selections, err := m.objectSelection(message, depth-1)
+	if err != nil || len(selections) == 0 {
+		return Selection{}, false, err
+	}
+
+	return Selection{Name: name, Selections: selections}, true, nil
+}
+
+func (m *jaalModule) fieldSelection(field pgs.Field, name string, depth int) (Selection, bool, error) {
+	// returns the selection of a payload field, false if the field is an object beyond depth
+
+	if entries, err := m.IsMapEntries(field); err != nil {
+		return Selection{}, false, err
+	} else if entries {
+		selection := Selection{Name: name, Selections: []Selection{{Name: "key"}}}
+		if value := m.mapValueMessage(field); value == nil {
+			selection.Selections = append(selection.Selections, Selection{Name: "value"})
+		} else if valueSelection, ok, err := m.valueSelection(value, "value", depth); err != nil {
+			return Selection{}, false, err
+		} else if ok {
+			selection.Selections = append(selection.Selections, valueSelection)
+		}
+		return selection, true, nil
+	} else if field.Type().IsMap() {
+		return Selection{Name: name}, true, nil
+	}
+
+	if idOption, err := m.IdOption(field); err != nil {
+		return Selection{}, false, err
+	} else if idOption || strings.ToLower(field.Name().String()) == "id" {
+		return Selection{Name: name}, true, nil
+	}
+
+	if field.Type().IsRepeated() && field.Type().Element().IsEmbed() {
+		return m.valueSelection(field.Type().Element().Embed(), name, depth)
+	} else if field.Type().IsEmbed() {
+		return m.valueSelection(field.Type().Embed(), name, depth)
+	}
+
+	return Selection{Name: name}, true, nil
+}
+
+func (m *jaalModule) rpcSelection(rpc pgs.Method, connection *Connection, depth int) ([]Selection, error) {
+	// returns the default selection set of the object returned by a query or subscription, connections select their nodes
+
+	node := rpc.Output()
+	if connection != nil {
+		for _, field := range rpc.Output().Fields() {
+			if field.Name().UpperCamelCase().String() == connection.Items {
+				node = field.Type().Element().Embed()
+			}
+		}
+	}
+
+	selections, err := m.objectSelection(node, depth)
+	if err != nil {
+		return nil, err
+	} else if len(selections) == 0 {
+		selections = []Selection{{Name: "__typename"}}
+	}
+
+	if connection == nil {
+		return selections, nil
+	}
+
+	return []Selection{
+		{Name: "edges", Selections: []Selection{{Name: "cursor"}, {Name: "node", Selections: selections}}},
+		{Name: "pageInfo", Selections: []Selection{{Name: "hasNextPage"}, {Name: "endCursor"}}},
+	}, nil
+}
+
+func writeSelection(buf *bytes.Buffer, selections []Selection, indent string) {
+	// writes a selection set, the opening brace is expected on the current line
+
+	buf.WriteString("{\n")
+	for _, selection := range selections {
+		buf.WriteString(indent + "    " + selection.Name)
+		if len(selection.Selections) != 0 {
+			buf.WriteString(" ")
+			writeSelection(buf, selection.Selections, indent+"    ")
+		}
+		buf.WriteString("\n")
+	}
+	buf.WriteString(indent + "}")
+}
+
+func (m *jaalModule) rpcOperation(rpc pgs.Method, PossibleReqObjects map[string]bool, depth int) (*Operation, error) {
+	// returns the operation document of an rpc, nil if the rpc is not registered
+
+	flag, option, err := m.GetOption(rpc)
+	if err != nil {
+		return nil, err
+	} else if !flag {
+		return nil, nil
+	}
+
+	operation := &Operation{Name: rpc.Name().UpperCamelCase().String(), Description: m.Description(rpc)}
+	buf := &bytes.Buffer{}
+
+	if option.GetMutation() != "" {
+		selections, err := m.objectSelection(rpc.Output(), depth)
+		if err != nil {
+			return nil, err
+		}
+		payload := Selection{Name: "payload", Selections: selections}
+		if len(selections) == 0 {
+			payload.Selections = []Selection{{Name: "__typename"}}
+		}
+
+		operation.Kind, operation.Field = "mutation", option.GetMutation()
+		operation.Variables = "($input: " + operation.Name + "Input)"
+		operation.Args = "(input: $input)"
+		writeSelection(buf, []Selection{{Name: "clientMutationId"}, payload}, "    ")
+		operation.Selection = buf.String()
+
+		return operation, nil
+	}
+
+	operation.Kind, operation.Field = "query", option.GetQuery()
+	if option.GetSubscription() != "" {
+		operation.Kind, operation.Field = "subscription", option.GetSubscription()
+	}
+
+	connection, err := m.GetConnection(rpc, option)
+	if err != nil {
+		return nil, err
+	}
+
+	args, err := m.sdlArgList(rpc, connection, PossibleReqObjects)
+	if err != nil {
+		return nil, err
+	}
+	if len(args) != 0 {
+		var variables, values []string
+		for _, arg := range args {
+			variables = append(variables, "$"+arg.Name+": "+arg.Type)
+			values = append(values, arg.Name+": $"+arg.Name)
+		}
+		operation.Variables = "(" + strings.Join(variables, ", ") + ")"
+		operation.Args = "(" + strings.Join(values, ", ") + ")"
+	}
+
+	selections, err := m.rpcSelection(rpc, connection, depth)
+	if err != nil {
+		return nil, err
+	}
+	writeSelection(buf, selections, "    ")
+	operation.Selection = buf.String()
+
+	return operation, nil
+}
+
+func (m *jaalModule) generateOperations(target pgs.File, depth int) (string, error) {
+	// returns the graphql operation documents of the rpcs of target registered on the schema, empty if there are none
+
+	PossibleReqObjects := make(map[string]bool)
+	for _, service := range target.Services() {
+		if err := m.getPossibleReqObjects(service, PossibleReqObjects); err != nil {
+			return "", err
+		}
+	}
+
+	var operations []Operation
+	for _, service := range target.Services() {
+		for _, rpc := range service.Methods() {
+			operation, err := m.rpcOperation(rpc, PossibleReqObjects, depth)
+			if err != nil {
+				return "", err
+			} else if operation != nil {
+				operations = append(operations, *operation)
+			}
+		}
+	}
+
+	if len(operations) == 0 {
+		return "", nil
+	}
+
+	tmp := getOperationsTemplate()
+	buf := &bytes.Buffer{}
+
+	if err := tmp.Execute(buf, operations); err != nil {
+		return "", err
+	}
+
+	return buf.String(), nil
+}
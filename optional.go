@@ -0,0 +1,130 @@
+package main
+
+import (
+	"io"
+
+	"github.com/golang/protobuf/proto"
+	pgs "github.com/lyft/protoc-gen-star"
+)
+
+// proto3OptionalField is the number of proto3_optional in FieldDescriptorProto. The descriptor
+// package in use predates it, so the flag is read from the unrecognized bytes of the descriptor.
+const proto3OptionalField = 17
+
+func (m *jaalModule) IsProto3Optional(field pgs.Field) bool {
+	// returns true if field is a proto3 optional field, i.e. the only field of a synthetic oneof
+
+	buf := field.Descriptor().XXX_unrecognized
+	for len(buf) > 0 {
+		key, n := proto.DecodeVarint(buf)
+		if n == 0 {
+			return false
+		}
+		buf = buf[n:]
+
+		switch key & 7 {
+		case proto.WireVarint:
+			val, n := proto.DecodeVarint(buf)
+			if n == 0 {
+				return false
+			}
+			if key>>3 == proto3OptionalField {
+				return val != 0
+			}
+			buf = buf[n:]
+		case proto.WireFixed64:
+			if len(buf) < 8 {
+				return false
+			}
+			buf = buf[8:]
+		case proto.WireFixed32:
+			if len(buf) < 4 {
+				return false
+			}
+			buf = buf[4:]
+		case proto.WireBytes:
+			l, n := proto.DecodeVarint(buf)
+			if n == 0 || uint64(len(buf)-n) < l {
+				return false
+			}
+			buf = buf[n+int(l):]
+		default:
+			return false
+		}
+	}
+
+	return false
+}
+
+func (m *jaalModule) InOneOf(field pgs.Field) bool {
+	// returns true if field belongs to a oneof declared in proto, synthetic oneofs are ignored
+
+	return field.InOneOf() && !m.IsProto3Optional(field)
+}
+
+func (m *jaalModule) OneOfs(message pgs.Message) []pgs.OneOf {
+	// returns the oneofs declared in proto, without the synthetic oneofs of proto3 optional fields
+
+	var oneOfs []pgs.OneOf
+	for _, oneOf := range message.OneOfs() {
+		if fields := oneOf.Fields(); len(fields) == 1 && m.IsProto3Optional(fields[0]) {
+			continue
+		}
+		oneOfs = append(oneOfs, oneOf)
+	}
+
+	return oneOfs
+}
+
+func (m *jaalModule) NonOneOfFields(message pgs.Message) []pgs.Field {
+	// returns the fields not in a declared oneof, proto3 optional fields included
+
+	var fields []pgs.Field
+	for _, field := range message.Fields() {
+		if !m.InOneOf(field) {
+			fields = append(fields, field)
+		}
+	}
+
+	return fields
+}
+
+func (m *jaalModule) IsPresenceField(field pgs.Field) bool {
+	// returns true if protoc-gen-go generates a pointer to track the presence of a scalar or enum field
+
+	if !m.IsProto3Optional(field) || field.Type().IsEmbed() {
+		return false
+	}
+
+	return field.Type().ProtoType() != pgs.BytesT
+}
+
+// featureWriter declares the features supported by the plugin on the response written to protoc.
+// protoc rejects the files with proto3 optional fields unless the plugin declares FEATURE_PROTO3_OPTIONAL,
+// but the CodeGeneratorResponse of golang/protobuf v1.3.1, used by protoc-gen-star, predates
+// supported_features (2) and protoc-gen-star has no hook on the response. The field is appended to
+// the encoded response, a message followed by a field decodes as the message with that field set.
+// protoc-gen-star writes the whole response with one Write, so each Write is a complete response;
+// the output of the plugin is checked by TestSupportedFeatures.
+type featureWriter struct {
+	io.Writer
+}
+
+// featureProto3Optional is FEATURE_PROTO3_OPTIONAL.
+const featureProto3Optional = 1
+
+func (w featureWriter) Write(p []byte) (int, error) {
+	buf := proto.NewBuffer(append([]byte(nil), p...))
+	if err := buf.EncodeVarint(2<<3 | proto.WireVarint); err != nil {
+		return 0, err
+	}
+	if err := buf.EncodeVarint(featureProto3Optional); err != nil {
+		return 0, err
+	}
+
+	if _, err := w.Writer.Write(buf.Bytes()); err != nil {
+		return 0, err
+	}
+
+	return len(p), nil
+}
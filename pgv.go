@@ -0,0 +1,73 @@
+package main
+
+import (
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/protoc-gen-go/descriptor"
+	pgs "github.com/lyft/protoc-gen-star"
+)
+
+// the options of protoc-gen-validate are looked up by field number, its extensions are not registered by the plugin
+var (
+	pgvDisabled = &proto.ExtensionDesc{ExtendedType: (*descriptor.MessageOptions)(nil), Field: 1071}
+	pgvRequired = &proto.ExtensionDesc{ExtendedType: (*descriptor.OneofOptions)(nil), Field: 1071}
+	pgvRules    = &proto.ExtensionDesc{ExtendedType: (*descriptor.FieldOptions)(nil), Field: 1071}
+)
+
+func (m *jaalModule) HasValidateRules(message pgs.Message) bool {
+	// returns true if a field of message or of its embedded messages has validation rules of protoc-gen-validate
+
+	return m.hasValidateRules(message, make(map[string]bool))
+}
+
+func (m *jaalModule) hasValidateRules(message pgs.Message, visited map[string]bool) bool {
+	if visited[message.FullyQualifiedName()] {
+		return false
+	}
+	visited[message.FullyQualifiedName()] = true
+
+	if opt := message.Descriptor().GetOptions(); opt != nil && proto.HasExtension(opt, pgvDisabled) {
+		return false
+	}
+
+	for _, oneof := range message.OneOfs() {
+		if opt := oneof.Descriptor().GetOptions(); opt != nil && proto.HasExtension(opt, pgvRequired) {
+			return true
+		}
+	}
+
+	for _, field := range message.Fields() {
+		if opt := field.Descriptor().GetOptions(); opt != nil && proto.HasExtension(opt, pgvRules) {
+			return true
+		}
+
+		var embed pgs.Message
+		if field.Type().IsMap() {
+			embed = field.Type().Element().Embed()
+		} else if field.Type().IsRepeated() {
+			embed = field.Type().Element().Embed()
+		} else {
+			embed = field.Type().Embed()
+		}
+		if embed != nil && m.hasValidateRules(embed, visited) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (m *jaalModule) PackageValidates(files []pgs.File) bool {
+	// returns true if an rpc registered by the files of a package validates its request
+
+	for _, file := range files {
+		for _, service := range file.Services() {
+			for _, rpc := range service.Methods() {
+				if flag, _, err := m.GetOption(rpc); err == nil && flag && m.HasValidateRules(rpc.Input()) {
+					return true
+				}
+			}
+		}
+	}
+
+	return false
+}
@@ -2,6 +2,13 @@ package main
 
 import (
 	"bytes"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
 
 	pgs "github.com/lyft/protoc-gen-star"
 )
@@ -16,8 +23,15 @@ func (m *jaalModule) generateFileData(target pgs.File) (string, error) {
 	initFunctionsName := make(map[string]bool)
 	imports := m.GetImports(target)
 
-	for key, _ := range imports {
-		buf.WriteString("import \"" + key + "\";\n")
+	// imports are sorted so the generated code is stable
+	var importPaths []string
+	for key := range imports {
+		importPaths = append(importPaths, key)
+	}
+	sort.Strings(importPaths)
+
+	for _, key := range importPaths {
+		buf.WriteString("import " + goImportAlias(key, imports[key]) + " \"" + key + "\";\n")
 	}
 	buf.WriteString("import \"context\";")
 	buf.WriteString("import \"encoding/json\";")
@@ -25,6 +39,22 @@ func (m *jaalModule) generateFileData(target pgs.File) (string, error) {
 	buf.WriteString("import \"go.appointy.com/jaal/gtypes\";")
 	buf.WriteString("import \"go.appointy.com/jaal/schemabuilder\";")
 
+	for _, msgs := range target.AllMessages() {
+		// entries are sorted by key
+		if entries, err := m.MapEntries(msgs); err != nil {
+			return "", err
+		} else if len(entries) != 0 {
+			buf.WriteString("import \"sort\";")
+			break
+		}
+	}
+
+	if m.FileSubscriptions(target) {
+		// the streams of subscriptions end with io.EOF, their other errors are logged
+		buf.WriteString("import \"io\";")
+		buf.WriteString("import \"log\";")
+	}
+
 	for _, enums := range target.AllEnums() { //enum type
 		str, err := m.EnumType(enums, imports, initFunctionsName)
 
@@ -51,6 +81,14 @@ func (m *jaalModule) generateFileData(target pgs.File) (string, error) {
 		buf.WriteString(str + "\n")
 	}
 
+	for _, msgs := range target.AllMessages() { // map entry Type
+		str, err := m.MapEntryType(msgs, initFunctionsName)
+		if err != nil {
+			return "", err
+		}
+		buf.WriteString(str + "\n")
+	}
+
 	for _, msgs := range target.AllMessages() { // oneof Input Type
 		str, err := m.OneofInputType(msgs, imports, initFunctionsName)
 		if err != nil {
@@ -83,6 +121,14 @@ func (m *jaalModule) generateFileData(target pgs.File) (string, error) {
 		buf.WriteString(str + "\n")
 	}
 
+	for _, msgs := range target.AllMessages() { // fields resolved by other rpcs
+		str, err := m.ResolverType(msgs)
+		if err != nil {
+			return "", err
+		}
+		buf.WriteString(str + "\n")
+	}
+
 	for _, service := range target.Services() { // mutation input struct
 		str, err := m.ServiceStructInput(service)
 		if err != nil {
@@ -123,7 +169,7 @@ func (m *jaalModule) generateFileData(target pgs.File) (string, error) {
 		buf.WriteString(str + "\n")
 	}
 
-	if str, err := m.InitFunc(initFunctionsName); err != nil { // init
+	if str, err := m.InitFunc(target, initFunctionsName); err != nil { // init
 		return "", err
 	} else {
 		buf.WriteString(str + "\n")
@@ -134,5 +180,251 @@ func (m *jaalModule) generateFileData(target pgs.File) (string, error) {
 	} else {
 		buf.WriteString(str + "\n")
 	}
+
+	// the imports are written before the code, the ones the code does not use are removed
+	return pruneImports(buf.String())
+}
+
+func pruneImports(src string) (string, error) {
+	// returns src with the imports its code refers to, grouped in one import declaration
+
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, "", src, parser.ImportsOnly)
+	if err != nil || len(file.Imports) == 0 {
+		return src, err
+	}
+	body, err := parser.ParseFile(fset, "", src, 0)
+	if err != nil {
+		return "", err
+	}
+
+	// the identifiers selected from are packages when the parser does not resolve them to a declaration of the file
+	used := make(map[string]bool)
+	ast.Inspect(body, func(node ast.Node) bool {
+		if selector, ok := node.(*ast.SelectorExpr); ok {
+			if ident, ok := selector.X.(*ast.Ident); ok && ident.Obj == nil {
+				used[ident.Name] = true
+			}
+		}
+		return true
+	})
+
+	var std, others []string
+	for _, spec := range file.Imports {
+		// the packages are imported under their name when it is not the last element of their path
+		importPath, _ := strconv.Unquote(spec.Path.Value)
+		name := path.Base(importPath)
+		if spec.Name != nil {
+			name = spec.Name.Name
+		}
+		if !used[name] {
+			continue
+		}
+		// standard packages have no dot in their first element, they are grouped before the others as in jaal.pb.gq.go
+		if strings.Contains(strings.Split(importPath, "/")[0], ".") {
+			others = append(others, src[fset.Position(spec.Pos()).Offset:fset.Position(spec.End()).Offset])
+		} else {
+			std = append(std, src[fset.Position(spec.Pos()).Offset:fset.Position(spec.End()).Offset])
+		}
+	}
+
+	// the import declarations written one by one are replaced by the declaration of the used imports
+	start, end := fset.Position(file.Decls[0].Pos()).Offset, fset.Position(file.Decls[len(file.Decls)-1].End()).Offset
+	buf := &bytes.Buffer{}
+	buf.WriteString(src[:start])
+	buf.WriteString("import (\n" + strings.Join(std, "\n") + "\n\n" + strings.Join(others, "\n") + "\n)\n")
+	buf.WriteString(strings.TrimPrefix(src[end:], ";"))
+
+	return buf.String(), nil
+}
+
+func (m *jaalModule) InitOption() (bool, error) {
+	// returns init parameter, when false the types are only registered by the generated Register functions
+	return m.Parameters().BoolDefault("init", true)
+}
+
+func (m *jaalModule) FileTypesFunc(target pgs.File) string {
+	// returns name of the function registering the types of a file, e.g. RegisterCustomerTypes for customer.proto
+
+	name := path.Base(target.Name().String())
+	name = strings.TrimSuffix(name, path.Ext(name))
+
+	return "Register" + pgs.Name(name).UpperCamelCase().String() + "Types"
+}
+
+func (m *jaalModule) FileSubscriptions(target pgs.File) bool {
+	// returns true if an rpc of the services of a file is registered as subscription
+
+	for _, service := range target.Services() {
+		for _, rpc := range service.Methods() {
+			if flag, option, err := m.GetOption(rpc); err == nil && flag && option.GetSubscription() != "" {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+func (m *jaalModule) PackageServices(files []pgs.File) bool {
+	// returns true if the files of a package have a service, its operations are registered by Register<Service>Operations
+
+	for _, file := range files {
+		if len(file.Services()) != 0 {
+			return true
+		}
+	}
+
+	return false
+}
+
+type Import struct {
+	Name string
+	Path string
+}
+
+type PackageData struct {
+	Package         string
+	Init            bool
+	Files           []string
+	StdImports      []string
+	Imports         []Import
+	Nodes           []Node
+	Clients         []NodeClient
+	Connections     []Connection
+	Wrappers        []Wrapper
+	WKTs            []WKT
+	JSONTypes       []JSONType
+	AnyType         string
+	AnyMembers      []AnyMember
+	Resolvers       []MessageResolvers
+	ResolverClients []NodeClient
+	Loaders         []Loader
+	Validate        bool
+	Required        bool
+	Calls           bool
+}
+
+func (m *jaalModule) generatePackageData(files []pgs.File) (string, error) {
+	// returns the registrations shared by all the files of a package and the RegisterTypes function of the package
+
+	nodes, clients, err := m.PackageNodes(files)
+	if err != nil {
+		return "", err
+	}
+
+	connections, err := m.PackageConnections(files)
+	if err != nil {
+		return "", err
+	}
+
+	wrappers := m.PackageWrappers(files)
+	wkts := m.PackageWKTs(files)
+	jsonTypes := m.PackageJSONTypes(files)
+
+	anyMembers, err := m.PackageAnyMembers(files)
+	if err != nil {
+		return "", err
+	}
+
+	resolvers, resolverClients, err := m.PackageResolvers(files)
+	if err != nil {
+		return "", err
+	}
+
+	loaders, err := m.PackageLoaders(files)
+	if err != nil {
+		return "", err
+	}
+
+	init, err := m.InitOption()
+	if err != nil {
+		return "", err
+	}
+
+	required, err := m.PackageRequires(files)
+	if err != nil {
+		return "", err
+	}
+
+	data := PackageData{Package: m.GetGoPackage(files[0]), Init: init, Nodes: nodes, Clients: clients, Connections: connections, Wrappers: wrappers, WKTs: wkts, JSONTypes: jsonTypes, AnyMembers: anyMembers, Resolvers: resolvers, ResolverClients: resolverClients, Loaders: loaders, Validate: m.PackageValidates(files), Required: required}
+
+	for _, file := range files {
+		data.Files = append(data.Files, m.FileTypesFunc(file))
+	}
+
+	// imports maps the import paths to their name, empty for the packages named after the last element of their path
+	imports := map[string]string{"go.appointy.com/jaal/schemabuilder": ""}
+	if len(nodes) != 0 {
+		for _, i := range []string{"context", "encoding/base64", "fmt", "strings", "go.appointy.com/jaal/schemabuilder"} {
+			imports[i] = ""
+		}
+	}
+	if len(connections) != 0 {
+		imports["context"] = ""
+		if init {
+			imports["go.appointy.com/jaal/gtypes"] = ""
+		}
+	}
+	for _, wrapper := range wrappers {
+		imports[wrapper.Import] = goImportAlias(wrapper.Import, wrapper.Package)
+		if wrapper.ValueType == "[]byte" {
+			imports["go.appointy.com/jaal/schemabuilder"] = ""
+		}
+	}
+	for _, wkt := range wkts {
+		imports[wkt.Import] = goImportAlias(wkt.Import, wkt.Package)
+		imports["go.appointy.com/jaal/schemabuilder"] = ""
+	}
+	for _, jsonType := range jsonTypes {
+		imports[jsonType.Import] = goImportAlias(jsonType.Import, jsonType.Package)
+		imports["github.com/golang/protobuf/jsonpb"] = ""
+		imports["encoding/base64"] = ""
+		imports["go.appointy.com/jaal/schemabuilder"] = ""
+		if jsonType.Name == "Any" {
+			data.AnyType = jsonType.GoType
+		}
+	}
+	// the rpcs called by the generated code are prepared by the call interceptors and their errors are mapped by the error mapper of their registration
+	data.Calls = len(nodes) != 0 || len(resolvers) != 0 || len(loaders) != 0 || m.PackageServices(files)
+	if data.Calls {
+		for _, i := range []string{"context", "strings", "github.com/golang/protobuf/ptypes", "google.golang.org/genproto/googleapis/rpc/errdetails", "google.golang.org/grpc", "google.golang.org/grpc/codes", "google.golang.org/grpc/metadata", "google.golang.org/grpc/status"} {
+			imports[i] = ""
+		}
+	}
+	if data.Validate || data.Required {
+		imports["fmt"] = ""
+	}
+	if data.Validate {
+		imports["strings"] = ""
+	}
+	if len(loaders) != 0 {
+		for _, i := range []string{"context", "sync", "time"} {
+			imports[i] = ""
+		}
+	}
+	if len(anyMembers) != 0 {
+		imports["fmt"] = ""
+		imports["github.com/golang/protobuf/ptypes"] = ""
+	}
+
+	for i, name := range imports {
+		// standard packages have no dot in their first element
+		if strings.Contains(strings.Split(i, "/")[0], ".") {
+			data.Imports = append(data.Imports, Import{Name: name, Path: i})
+		} else {
+			data.StdImports = append(data.StdImports, i)
+		}
+	}
+	sort.Strings(data.StdImports)
+	sort.Slice(data.Imports, func(i, j int) bool { return data.Imports[i].Path < data.Imports[j].Path })
+
+	tmp := getPackageTemplate()
+	buf := &bytes.Buffer{}
+
+	if err := tmp.Execute(buf, data); err != nil {
+		return "", err
+	}
+
 	return buf.String(), nil
 }
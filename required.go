@@ -0,0 +1,242 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/protoc-gen-go/descriptor"
+	pgs "github.com/lyft/protoc-gen-star"
+	pbt "go.appointy.com/protoc-gen-jaal/schema"
+)
+
+// google.api.field_behavior is looked up by field number as the options of protoc-gen-validate, REQUIRED is 2
+var fieldBehavior = &proto.ExtensionDesc{ExtendedType: (*descriptor.FieldOptions)(nil), ExtensionType: ([]int32)(nil), Field: 1052, Name: "google.api.field_behavior", Tag: "varint,1052,rep,name=field_behavior"}
+
+const fieldBehaviorRequired = 2
+
+type RequiredCheck struct {
+	Path string
+	Cond string
+}
+
+func (m *jaalModule) GetRequiredOption(field pgs.Field) (bool, error) {
+	//returns required option for a message field
+
+	opt := field.Descriptor().GetOptions()
+	if opt == nil {
+		return false, nil
+	}
+
+	x, err := proto.GetExtension(opt, pbt.E_Required)
+	if err != nil {
+		if err == proto.ErrMissingExtension {
+			return false, nil
+		}
+		return false, fmt.Errorf("%s: %v", field.FullyQualifiedName(), err)
+	}
+
+	return *x.(*bool), nil
+}
+
+func (m *jaalModule) GetNullableOption(field pgs.Field) (bool, error) {
+	//returns nullable option for a message field
+
+	opt := field.Descriptor().GetOptions()
+	if opt == nil {
+		return false, nil
+	}
+
+	x, err := proto.GetExtension(opt, pbt.E_Nullable)
+	if err != nil {
+		if err == proto.ErrMissingExtension {
+			return false, nil
+		}
+		return false, fmt.Errorf("%s: %v", field.FullyQualifiedName(), err)
+	}
+
+	return *x.(*bool), nil
+}
+
+func (m *jaalModule) hasFieldBehaviorRequired(field pgs.Field) bool {
+	// returns true if field is tagged with google.api.field_behavior REQUIRED
+
+	opt := field.Descriptor().GetOptions()
+	if opt == nil || !proto.HasExtension(opt, fieldBehavior) {
+		return false
+	}
+
+	x, err := proto.GetExtension(opt, fieldBehavior)
+	if err != nil {
+		return false
+	}
+	for _, behavior := range x.([]int32) {
+		if behavior == fieldBehaviorRequired {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (m *jaalModule) IsRequired(field pgs.Field) (bool, error) {
+	/*
+		returns true if a request without a value for field is rejected, i.e. field is tagged with required or with google.api.field_behavior REQUIRED
+		false of a bool field is a value and the members of a oneof are not required on their own, field_behavior is ignored on them
+	*/
+
+	option, err := m.GetRequiredOption(field)
+	if err != nil {
+		return false, err
+	}
+
+	skip, err := m.GetFieldOptionInput(field)
+	if err != nil {
+		return false, err
+	}
+
+	invalid := ""
+	switch {
+	case m.InOneOf(field):
+		invalid = "required can not be set on a field of a oneof"
+	case skip:
+		invalid = "required can not be set on a field skipped on the input"
+	case !field.Type().IsRepeated() && !field.Type().IsMap() && field.Type().ProtoType() == pgs.BoolT && !m.IsProto3Optional(field):
+		invalid = "required can not be set on a bool field, false can not be told apart from no value"
+	}
+
+	if invalid != "" {
+		if option {
+			return false, fmt.Errorf("%s: %s", field.FullyQualifiedName(), invalid)
+		}
+		return false, nil
+	}
+
+	return option || m.hasFieldBehaviorRequired(field), nil
+}
+
+func (m *jaalModule) IsNullable(field pgs.Field) (bool, error) {
+	// returns true if the zero value of a scalar or enum field is exposed as null on the payload
+
+	option, err := m.GetNullableOption(field)
+	if err != nil || !option {
+		return false, err
+	}
+
+	idOption, err := m.IdOption(field)
+	if err != nil {
+		return false, err
+	}
+
+	protoType := field.Type().ProtoType()
+	if field.Type().IsRepeated() || field.Type().IsMap() || protoType == pgs.MessageT || protoType == pgs.BytesT || protoType == pgs.BoolT || m.InOneOf(field) || m.IsProto3Optional(field) || idOption || strings.ToLower(field.Name().String()) == "id" {
+		return false, fmt.Errorf("%s: nullable can only be set on singular scalar and enum fields", field.FullyQualifiedName())
+	}
+
+	return true, nil
+}
+
+func (m *jaalModule) zeroValue(field pgs.Field) string {
+	// returns the go zero value of a nullable field
+
+	if field.Type().ProtoType() == pgs.StringT {
+		return `""`
+	}
+	return "0"
+}
+
+func (m *jaalModule) RequiredChecks(message pgs.Message, prefix string, args map[string]string) ([]RequiredCheck, error) {
+	/*
+		returns the checks of the required fields of a request and of its singular embedded messages
+		prefix is the path of the request in the arguments, args renames the request fields exposed as other arguments
+	*/
+
+	return m.requiredChecks(message, "request", "", prefix, args, make(map[string]bool))
+}
+
+func (m *jaalModule) requiredChecks(message pgs.Message, value string, guard string, prefix string, args map[string]string, visited map[string]bool) ([]RequiredCheck, error) {
+	if visited[message.FullyQualifiedName()] {
+		return nil, nil
+	}
+	visited[message.FullyQualifiedName()] = true
+	defer delete(visited, message.FullyQualifiedName())
+
+	var checks []RequiredCheck
+	for _, field := range message.Fields() {
+		name := field.Name().LowerCamelCase().String()
+		if renamed, ok := args[field.Name().UpperCamelCase().String()]; ok {
+			name = renamed
+		} else if guard != "" {
+			// embedded messages are input objects, their fields can be renamed
+			override, fieldName, err := m.getFieldNameOption(field)
+			if err != nil {
+				return nil, err
+			} else if override {
+				name = fieldName
+			}
+		}
+		path := name
+		if prefix != "" {
+			path = prefix + "." + name
+		}
+		fieldValue := value + "." + m.Context.Name(field).String()
+
+		required, err := m.IsRequired(field)
+		if err != nil {
+			return nil, err
+		}
+
+		if required {
+			protoType := field.Type().ProtoType()
+			cond := fieldValue + " == 0"
+			switch {
+			case field.Type().IsRepeated() || field.Type().IsMap() || protoType == pgs.BytesT:
+				cond = "len(" + fieldValue + ") == 0"
+			case protoType == pgs.MessageT || m.IsPresenceField(field):
+				cond = fieldValue + " == nil"
+			case protoType == pgs.StringT:
+				cond = fieldValue + ` == ""`
+			}
+			checks = append(checks, RequiredCheck{Path: path, Cond: guard + cond})
+		}
+
+		if field.Type().IsRepeated() || field.Type().IsMap() || !field.Type().IsEmbed() || m.InOneOf(field) {
+			continue
+		}
+
+		// the fields of an embedded message are required once the message is set
+		embedded, err := m.requiredChecks(field.Type().Embed(), fieldValue, guard+fieldValue+" != nil && ", path, nil, visited)
+		if err != nil {
+			return nil, err
+		}
+		checks = append(checks, embedded...)
+	}
+
+	return checks, nil
+}
+
+func (m *jaalModule) PackageRequires(files []pgs.File) (bool, error) {
+	// returns true if an rpc registered by the files of a package checks the required fields of its request
+
+	for _, file := range files {
+		for _, service := range file.Services() {
+			for _, rpc := range service.Methods() {
+				flag, _, err := m.GetOption(rpc)
+				if err != nil {
+					return false, err
+				} else if !flag {
+					continue
+				}
+
+				checks, err := m.RequiredChecks(rpc.Input(), "", nil)
+				if err != nil {
+					return false, err
+				} else if len(checks) != 0 {
+					return true, nil
+				}
+			}
+		}
+	}
+
+	return false, nil
+}
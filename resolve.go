@@ -0,0 +1,299 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+
+	"github.com/golang/protobuf/proto"
+	pgs "github.com/lyft/protoc-gen-star"
+	pbt "go.appointy.com/protoc-gen-jaal/schema"
+)
+
+type Resolver struct {
+	FieldName   string
+	Name        string
+	Repeated    bool
+	Zero        string
+	Client      string
+	ClientType  string
+	Method      string
+	RequestType string
+	Arg         string
+	ReturnType  string
+	TypeName    string
+	Loader      *Loader
+	FullMethod  string
+	Deprecation string
+}
+
+type MessageResolvers struct {
+	Name           string
+	PayloadObjName string
+	Clients        []NodeClient
+	Resolvers      []Resolver
+}
+
+func (m *jaalModule) GetResolveOption(field pgs.Field) (*pbt.Resolve, error) {
+	//returns resolve option of a field, nil if field is not resolved
+
+	opt := field.Descriptor().GetOptions()
+	if opt == nil {
+		return nil, nil
+	}
+
+	x, err := proto.GetExtension(opt, pbt.E_Resolve)
+	if err != nil {
+		if err == proto.ErrMissingExtension {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("%s: %v", field.FullyQualifiedName(), err)
+	}
+
+	return x.(*pbt.Resolve), nil
+}
+
+func (m *jaalModule) resolveRPC(entity pgs.Entity, name string) (pgs.Method, error) {
+	// returns the rpc named by an option of entity, rpc is looked up in the services of the package of entity
+
+	serviceName, methodName := "", name
+	if i := strings.LastIndex(name, "."); i != -1 {
+		serviceName, methodName = name[:i], name[i+1:]
+	}
+
+	for _, file := range entity.Package().Files() {
+		for _, service := range file.Services() {
+			if serviceName != "" && service.Name().String() != serviceName {
+				continue
+			}
+
+			for _, rpc := range service.Methods() {
+				if rpc.Name().String() != methodName {
+					continue
+				}
+
+				if rpc.ClientStreaming() || rpc.ServerStreaming() {
+					return nil, fmt.Errorf("%s: rpc %s must be unary", entity.FullyQualifiedName(), name)
+				}
+
+				return rpc, nil
+			}
+		}
+	}
+
+	return nil, fmt.Errorf("%s: rpc %s not found in package %s", entity.FullyQualifiedName(), name, entity.Package().ProtoName())
+}
+
+func (m *jaalModule) ResolverOf(field pgs.Field) (*Resolver, error) {
+	/*
+		returns the resolver of a field tagged with resolve, nil if field is not resolved
+		the value of the field is set on arg of the request of rpc, a repeated field is resolved element wise
+	*/
+
+	option, err := m.GetResolveOption(field)
+	if err != nil || option == nil {
+		return nil, err
+	}
+
+	protoType := field.Type().ProtoType()
+	if field.Type().IsRepeated() {
+		protoType = field.Type().Element().ProtoType()
+	}
+	if field.Type().IsMap() || protoType == pgs.MessageT || protoType == pgs.EnumT || protoType == pgs.BytesT || m.IsProto3Optional(field) {
+		return nil, fmt.Errorf("%s: resolve can be used on scalar fields only", field.FullyQualifiedName())
+	}
+
+	rpc, err := m.resolveRPC(field, option.GetRpc())
+	if err != nil {
+		return nil, err
+	}
+
+	argName := option.GetArg()
+	if argName == "" {
+		argName = "id"
+	}
+	arg, err := m.connectionField(rpc.Input(), argName, argName)
+	if err != nil {
+		return nil, err
+	} else if arg.Type().IsRepeated() || arg.Type().ProtoType() != protoType || m.InOneOf(arg) || m.IsProto3Optional(arg) {
+		return nil, fmt.Errorf("%s: resolve arg %s must be a singular field of %s with the type of the field", field.FullyQualifiedName(), argName, rpc.Input().Name())
+	}
+
+	fieldName := option.GetName()
+	if fieldName == "" {
+		name := field.Name().String()
+		if field.Type().IsRepeated() && strings.HasSuffix(name, "_ids") {
+			fieldName = pgs.Name(strings.TrimSuffix(name, "_ids") + "s").LowerCamelCase().String()
+		} else if !field.Type().IsRepeated() && strings.HasSuffix(name, "_id") {
+			fieldName = pgs.Name(strings.TrimSuffix(name, "_id")).LowerCamelCase().String()
+		} else {
+			return nil, fmt.Errorf("%s: resolve name is required for a field not named after an id", field.FullyQualifiedName())
+		}
+	}
+
+	typeName, err := m.PayloadObjectName(rpc.Output())
+	if err != nil {
+		return nil, err
+	}
+
+	loader, err := m.resolveLoader(field, rpc, arg)
+	if err != nil {
+		return nil, err
+	}
+
+	// the resolved field is deprecated with the field
+	deprecation, err := m.Deprecation(field)
+	if err != nil {
+		return nil, err
+	}
+
+	zero := "0"
+	if protoType == pgs.StringT {
+		zero = `""`
+	} else if protoType == pgs.BoolT {
+		zero = "false"
+	}
+
+	return &Resolver{
+		FieldName:   fieldName,
+		Name:        field.Name().UpperCamelCase().String(),
+		Repeated:    field.Type().IsRepeated(),
+		Zero:        zero,
+		Client:      rpc.Service().Name().LowerCamelCase().String() + "Client",
+		ClientType:  rpc.Service().Name().UpperCamelCase().String() + "Client",
+		Method:      rpc.Name().UpperCamelCase().String(),
+		RequestType: m.Context.Name(rpc.Input()).String(),
+		Arg:         arg.Name().UpperCamelCase().String(),
+		ReturnType:  m.Context.Name(rpc.Output()).String(),
+		TypeName:    typeName,
+		Loader:      loader,
+		FullMethod:  m.FullMethod(rpc),
+		Deprecation: deprecation,
+	}, nil
+}
+
+func (m *jaalModule) resolveLoader(field pgs.Field, rpc pgs.Method, arg pgs.Field) (*Loader, error) {
+	/*
+		returns the loader batching the calls of a resolved field, nil if rpc is called directly
+		the loader of the returned message is used when arg is its key or rpc is the get_rpc of the node, loaders are generated in the package of their message
+	*/
+
+	if rpc.Output().Package().ProtoName() != field.Package().ProtoName() {
+		return nil, nil
+	}
+
+	loader, err := m.LoaderOf(rpc.Output())
+	if err != nil || loader == nil {
+		return nil, err
+	}
+
+	if arg.Name().UpperCamelCase().String() != loader.Key {
+		node, getRPC, err := m.GetNodeOption(rpc.Output())
+		if err != nil {
+			return nil, err
+		} else if !node {
+			return nil, nil
+		}
+
+		nodeRPC, err := m.nodeGetRPC(rpc.Output(), getRPC)
+		if err != nil {
+			return nil, err
+		} else if nodeRPC.FullyQualifiedName() != rpc.FullyQualifiedName() {
+			return nil, nil
+		}
+	}
+
+	if loader.KeyType != m.Context.Type(arg).String() {
+		return nil, fmt.Errorf("%s: resolve arg %s must have the type of the key of the loader of %s", field.FullyQualifiedName(), arg.Name(), rpc.Output().Name())
+	}
+
+	return loader, nil
+}
+
+func (m *jaalModule) MessageResolversOf(message pgs.Message) (*MessageResolvers, error) {
+	// returns the resolvers of the fields of a message and the clients they call, nil if no field is resolved
+
+	if skip, err := m.GetSkipOption(message); err != nil || skip {
+		return nil, err
+	}
+
+	payloadObjName, err := m.PayloadObjectName(message)
+	if err != nil {
+		return nil, err
+	}
+
+	resolvers := &MessageResolvers{Name: m.Context.Name(message).String(), PayloadObjName: payloadObjName}
+	clientAdded := make(map[string]bool)
+
+	for _, field := range m.NonOneOfFields(message) {
+		resolver, err := m.ResolverOf(field)
+		if err != nil {
+			return nil, err
+		} else if resolver == nil {
+			continue
+		}
+
+		if !clientAdded[resolver.Client] {
+			clientAdded[resolver.Client] = true
+			resolvers.Clients = append(resolvers.Clients, NodeClient{Name: resolver.Client, Type: resolver.ClientType})
+		}
+		if resolver.Loader != nil && !clientAdded[resolver.Loader.Client] {
+			clientAdded[resolver.Loader.Client] = true
+			resolvers.Clients = append(resolvers.Clients, NodeClient{Name: resolver.Loader.Client, Type: resolver.Loader.ClientType})
+		}
+		resolvers.Resolvers = append(resolvers.Resolvers, *resolver)
+	}
+
+	if len(resolvers.Resolvers) == 0 {
+		return nil, nil
+	}
+
+	return resolvers, nil
+}
+
+func (m *jaalModule) ResolverType(message pgs.Message) (string, error) {
+	// returns generated template(resolvers) registering the resolved fields of a message
+
+	resolvers, err := m.MessageResolversOf(message)
+	if err != nil || resolvers == nil {
+		return "", err
+	}
+
+	tmp := getResolverTemplate()
+	buf := &bytes.Buffer{}
+
+	if err := tmp.Execute(buf, resolvers); err != nil {
+		return "", err
+	}
+
+	return buf.String(), nil
+}
+
+func (m *jaalModule) PackageResolvers(files []pgs.File) ([]MessageResolvers, []NodeClient, error) {
+	// returns the resolvers of the messages of the files of a package and all the clients they call
+
+	var resolvers []MessageResolvers
+	var clients []NodeClient
+	clientAdded := make(map[string]bool)
+
+	for _, file := range files {
+		for _, message := range file.AllMessages() {
+			messageResolvers, err := m.MessageResolversOf(message)
+			if err != nil {
+				return nil, nil, err
+			} else if messageResolvers == nil {
+				continue
+			}
+
+			for _, client := range messageResolvers.Clients {
+				if !clientAdded[client.Name] {
+					clientAdded[client.Name] = true
+					clients = append(clients, client)
+				}
+			}
+			resolvers = append(resolvers, *messageResolvers)
+		}
+	}
+
+	return resolvers, clients, nil
+}
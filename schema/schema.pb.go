@@ -25,10 +25,15 @@ type MethodOptions struct {
 	// Types that are valid to be assigned to Type:
 	//	*MethodOptions_Query
 	//	*MethodOptions_Mutation
-	Type                 isMethodOptions_Type `protobuf_oneof:"type"`
-	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
-	XXX_unrecognized     []byte               `json:"-"`
-	XXX_sizecache        int32                `json:"-"`
+	//	*MethodOptions_Subscription
+	Type isMethodOptions_Type `protobuf_oneof:"type"`
+	// connection is used to expose a list query as relay connection.
+	Connection *Connection `protobuf:"bytes,4,opt,name=connection,proto3" json:"connection,omitempty"`
+	// deprecation_reason is the reason of the deprecation of the operation, the rpc is deprecated in the SDL when it is set.
+	DeprecationReason    string   `protobuf:"bytes,5,opt,name=deprecation_reason,json=deprecationReason,proto3" json:"deprecation_reason,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *MethodOptions) Reset()         { *m = MethodOptions{} }
@@ -68,10 +73,16 @@ type MethodOptions_Mutation struct {
 	Mutation string `protobuf:"bytes,2,opt,name=mutation,proto3,oneof"`
 }
 
+type MethodOptions_Subscription struct {
+	Subscription string `protobuf:"bytes,3,opt,name=subscription,proto3,oneof"`
+}
+
 func (*MethodOptions_Query) isMethodOptions_Type() {}
 
 func (*MethodOptions_Mutation) isMethodOptions_Type() {}
 
+func (*MethodOptions_Subscription) isMethodOptions_Type() {}
+
 func (m *MethodOptions) GetType() isMethodOptions_Type {
 	if m != nil {
 		return m.Type
@@ -93,12 +104,229 @@ func (m *MethodOptions) GetMutation() string {
 	return ""
 }
 
+func (m *MethodOptions) GetSubscription() string {
+	if x, ok := m.GetType().(*MethodOptions_Subscription); ok {
+		return x.Subscription
+	}
+	return ""
+}
+
+func (m *MethodOptions) GetConnection() *Connection {
+	if m != nil {
+		return m.Connection
+	}
+	return nil
+}
+
+func (m *MethodOptions) GetDeprecationReason() string {
+	if m != nil {
+		return m.DeprecationReason
+	}
+	return ""
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*MethodOptions) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
 		(*MethodOptions_Query)(nil),
 		(*MethodOptions_Mutation)(nil),
+		(*MethodOptions_Subscription)(nil),
+	}
+}
+
+// Connection names the paging fields of a list rpc.
+type Connection struct {
+	// page_size is the request field set from first. Defaults to page_size.
+	PageSize string `protobuf:"bytes,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// page_token is the request field set from after. Defaults to page_token.
+	PageToken string `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// items is the repeated response field holding the nodes of the connection. Defaults to the only repeated message field of the response.
+	Items string `protobuf:"bytes,3,opt,name=items,proto3" json:"items,omitempty"`
+	// next_token is the response field holding the token of the next page. Defaults to next_page_token.
+	NextToken            string   `protobuf:"bytes,4,opt,name=next_token,json=nextToken,proto3" json:"next_token,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Connection) Reset()         { *m = Connection{} }
+func (m *Connection) String() string { return proto.CompactTextString(m) }
+func (*Connection) ProtoMessage()    {}
+func (*Connection) Descriptor() ([]byte, []int) {
+	return fileDescriptor_98b0d2c3e7e0142d, []int{1}
+}
+
+func (m *Connection) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Connection.Unmarshal(m, b)
+}
+func (m *Connection) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Connection.Marshal(b, m, deterministic)
+}
+func (m *Connection) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Connection.Merge(m, src)
+}
+func (m *Connection) XXX_Size() int {
+	return xxx_messageInfo_Connection.Size(m)
+}
+func (m *Connection) XXX_DiscardUnknown() {
+	xxx_messageInfo_Connection.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Connection proto.InternalMessageInfo
+
+func (m *Connection) GetPageSize() string {
+	if m != nil {
+		return m.PageSize
+	}
+	return ""
+}
+
+func (m *Connection) GetPageToken() string {
+	if m != nil {
+		return m.PageToken
+	}
+	return ""
+}
+
+func (m *Connection) GetItems() string {
+	if m != nil {
+		return m.Items
+	}
+	return ""
+}
+
+func (m *Connection) GetNextToken() string {
+	if m != nil {
+		return m.NextToken
+	}
+	return ""
+}
+
+// Resolve names the rpc fetching the object referred by a field.
+type Resolve struct {
+	// rpc is the unary rpc (Service.Method or Method, in the package of the field) returning the object.
+	Rpc string `protobuf:"bytes,1,opt,name=rpc,proto3" json:"rpc,omitempty"`
+	// arg is the request field set from the value of the field. Defaults to id.
+	Arg string `protobuf:"bytes,2,opt,name=arg,proto3" json:"arg,omitempty"`
+	// name is the name of the resolved field on graphql schema. Defaults to the name of the field without its _id or _ids suffix.
+	Name                 string   `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Resolve) Reset()         { *m = Resolve{} }
+func (m *Resolve) String() string { return proto.CompactTextString(m) }
+func (*Resolve) ProtoMessage()    {}
+func (*Resolve) Descriptor() ([]byte, []int) {
+	return fileDescriptor_98b0d2c3e7e0142d, []int{2}
+}
+
+func (m *Resolve) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Resolve.Unmarshal(m, b)
+}
+func (m *Resolve) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Resolve.Marshal(b, m, deterministic)
+}
+func (m *Resolve) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Resolve.Merge(m, src)
+}
+func (m *Resolve) XXX_Size() int {
+	return xxx_messageInfo_Resolve.Size(m)
+}
+func (m *Resolve) XXX_DiscardUnknown() {
+	xxx_messageInfo_Resolve.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Resolve proto.InternalMessageInfo
+
+func (m *Resolve) GetRpc() string {
+	if m != nil {
+		return m.Rpc
+	}
+	return ""
+}
+
+func (m *Resolve) GetArg() string {
+	if m != nil {
+		return m.Arg
+	}
+	return ""
+}
+
+func (m *Resolve) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+// Loader names the batch rpc loading the objects of a message by their keys.
+type Loader struct {
+	// rpc is the unary rpc (Service.Method or Method, in the package of the message) returning the objects of a list of keys.
+	Rpc string `protobuf:"bytes,1,opt,name=rpc,proto3" json:"rpc,omitempty"`
+	// keys is the repeated request field set from the keys. Defaults to ids.
+	Keys string `protobuf:"bytes,2,opt,name=keys,proto3" json:"keys,omitempty"`
+	// items is the repeated response field holding the objects. Defaults to the only repeated message field of the response.
+	Items string `protobuf:"bytes,3,opt,name=items,proto3" json:"items,omitempty"`
+	// key is the field of the message matched with the keys. Defaults to id.
+	Key                  string   `protobuf:"bytes,4,opt,name=key,proto3" json:"key,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Loader) Reset()         { *m = Loader{} }
+func (m *Loader) String() string { return proto.CompactTextString(m) }
+func (*Loader) ProtoMessage()    {}
+func (*Loader) Descriptor() ([]byte, []int) {
+	return fileDescriptor_98b0d2c3e7e0142d, []int{3}
+}
+
+func (m *Loader) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Loader.Unmarshal(m, b)
+}
+func (m *Loader) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Loader.Marshal(b, m, deterministic)
+}
+func (m *Loader) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Loader.Merge(m, src)
+}
+func (m *Loader) XXX_Size() int {
+	return xxx_messageInfo_Loader.Size(m)
+}
+func (m *Loader) XXX_DiscardUnknown() {
+	xxx_messageInfo_Loader.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Loader proto.InternalMessageInfo
+
+func (m *Loader) GetRpc() string {
+	if m != nil {
+		return m.Rpc
+	}
+	return ""
+}
+
+func (m *Loader) GetKeys() string {
+	if m != nil {
+		return m.Keys
 	}
+	return ""
+}
+
+func (m *Loader) GetItems() string {
+	if m != nil {
+		return m.Items
+	}
+	return ""
+}
+
+func (m *Loader) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
 }
 
 var E_Schema = &proto.ExtensionDesc{
@@ -137,6 +365,33 @@ var E_Type = &proto.ExtensionDesc{
 	Filename:      "schema/schema.proto",
 }
 
+var E_Node = &proto.ExtensionDesc{
+	ExtendedType:  (*descriptor.MessageOptions)(nil),
+	ExtensionType: (*bool)(nil),
+	Field:         91118,
+	Name:          "graphql.node",
+	Tag:           "varint,91118,opt,name=node",
+	Filename:      "schema/schema.proto",
+}
+
+var E_GetRpc = &proto.ExtensionDesc{
+	ExtendedType:  (*descriptor.MessageOptions)(nil),
+	ExtensionType: (*string)(nil),
+	Field:         91119,
+	Name:          "graphql.get_rpc",
+	Tag:           "bytes,91119,opt,name=get_rpc",
+	Filename:      "schema/schema.proto",
+}
+
+var E_Loader = &proto.ExtensionDesc{
+	ExtendedType:  (*descriptor.MessageOptions)(nil),
+	ExtensionType: (*Loader)(nil),
+	Field:         91124,
+	Name:          "graphql.loader",
+	Tag:           "bytes,91124,opt,name=loader",
+	Filename:      "schema/schema.proto",
+}
+
 var E_FileSkip = &proto.ExtensionDesc{
 	ExtendedType:  (*descriptor.FileOptions)(nil),
 	ExtensionType: (*bool)(nil),
@@ -146,6 +401,33 @@ var E_FileSkip = &proto.ExtensionDesc{
 	Filename:      "schema/schema.proto",
 }
 
+var E_StripEnumPrefix = &proto.ExtensionDesc{
+	ExtendedType:  (*descriptor.FileOptions)(nil),
+	ExtensionType: (*bool)(nil),
+	Field:         91130,
+	Name:          "graphql.strip_enum_prefix",
+	Tag:           "varint,91130,opt,name=strip_enum_prefix",
+	Filename:      "schema/schema.proto",
+}
+
+var E_ValueName = &proto.ExtensionDesc{
+	ExtendedType:  (*descriptor.EnumValueOptions)(nil),
+	ExtensionType: (*string)(nil),
+	Field:         91129,
+	Name:          "graphql.value_name",
+	Tag:           "bytes,91129,opt,name=value_name",
+	Filename:      "schema/schema.proto",
+}
+
+var E_ValueSkip = &proto.ExtensionDesc{
+	ExtendedType:  (*descriptor.EnumValueOptions)(nil),
+	ExtensionType: (*bool)(nil),
+	Field:         91131,
+	Name:          "graphql.value_skip",
+	Tag:           "varint,91131,opt,name=value_skip",
+	Filename:      "schema/schema.proto",
+}
+
 var E_InputSkip = &proto.ExtensionDesc{
 	ExtendedType:  (*descriptor.FieldOptions)(nil),
 	ExtensionType: (*bool)(nil),
@@ -182,44 +464,127 @@ var E_FieldName = &proto.ExtensionDesc{
 	Filename:      "schema/schema.proto",
 }
 
+var E_MapEntries = &proto.ExtensionDesc{
+	ExtendedType:  (*descriptor.FieldOptions)(nil),
+	ExtensionType: (*bool)(nil),
+	Field:         91122,
+	Name:          "graphql.map_entries",
+	Tag:           "varint,91122,opt,name=map_entries",
+	Filename:      "schema/schema.proto",
+}
+
+var E_Resolve = &proto.ExtensionDesc{
+	ExtendedType:  (*descriptor.FieldOptions)(nil),
+	ExtensionType: (*Resolve)(nil),
+	Field:         91123,
+	Name:          "graphql.resolve",
+	Tag:           "bytes,91123,opt,name=resolve",
+	Filename:      "schema/schema.proto",
+}
+
+var E_Required = &proto.ExtensionDesc{
+	ExtendedType:  (*descriptor.FieldOptions)(nil),
+	ExtensionType: (*bool)(nil),
+	Field:         91125,
+	Name:          "graphql.required",
+	Tag:           "varint,91125,opt,name=required",
+	Filename:      "schema/schema.proto",
+}
+
+var E_Nullable = &proto.ExtensionDesc{
+	ExtendedType:  (*descriptor.FieldOptions)(nil),
+	ExtensionType: (*bool)(nil),
+	Field:         91126,
+	Name:          "graphql.nullable",
+	Tag:           "varint,91126,opt,name=nullable",
+	Filename:      "schema/schema.proto",
+}
+
+var E_DeprecationReason = &proto.ExtensionDesc{
+	ExtendedType:  (*descriptor.FieldOptions)(nil),
+	ExtensionType: (*string)(nil),
+	Field:         91127,
+	Name:          "graphql.deprecation_reason",
+	Tag:           "bytes,91127,opt,name=deprecation_reason",
+	Filename:      "schema/schema.proto",
+}
+
 func init() {
 	proto.RegisterType((*MethodOptions)(nil), "graphql.MethodOptions")
+	proto.RegisterType((*Connection)(nil), "graphql.Connection")
+	proto.RegisterType((*Resolve)(nil), "graphql.Resolve")
+	proto.RegisterType((*Loader)(nil), "graphql.Loader")
 	proto.RegisterExtension(E_Schema)
 	proto.RegisterExtension(E_Skip)
 	proto.RegisterExtension(E_Name)
 	proto.RegisterExtension(E_Type)
+	proto.RegisterExtension(E_Node)
+	proto.RegisterExtension(E_GetRpc)
+	proto.RegisterExtension(E_Loader)
 	proto.RegisterExtension(E_FileSkip)
+	proto.RegisterExtension(E_StripEnumPrefix)
+	proto.RegisterExtension(E_ValueName)
+	proto.RegisterExtension(E_ValueSkip)
 	proto.RegisterExtension(E_InputSkip)
 	proto.RegisterExtension(E_PayloadSkip)
 	proto.RegisterExtension(E_Id)
 	proto.RegisterExtension(E_FieldName)
+	proto.RegisterExtension(E_MapEntries)
+	proto.RegisterExtension(E_Resolve)
+	proto.RegisterExtension(E_Required)
+	proto.RegisterExtension(E_Nullable)
+	proto.RegisterExtension(E_DeprecationReason)
 }
 
 func init() { proto.RegisterFile("schema/schema.proto", fileDescriptor_98b0d2c3e7e0142d) }
 
 var fileDescriptor_98b0d2c3e7e0142d = []byte{
-	// 355 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0x4f, 0x4f, 0xf2, 0x40,
-	0x10, 0x87, 0x5f, 0x08, 0xf0, 0xd2, 0x41, 0x2f, 0x35, 0x21, 0x44, 0x41, 0x89, 0x27, 0x4e, 0xdb,
-	0x44, 0xe3, 0x05, 0x13, 0x0f, 0x1c, 0x8c, 0x17, 0xd4, 0xd4, 0x9b, 0x17, 0xb2, 0xd0, 0xa5, 0xac,
-	0x6e, 0xbb, 0x4b, 0xbb, 0x3d, 0xf4, 0x13, 0xfa, 0x51, 0xfc, 0x9f, 0xe8, 0x37, 0x30, 0x3b, 0x5d,
-	0x48, 0x88, 0x24, 0xf5, 0xd4, 0x74, 0x67, 0x9e, 0x67, 0x7e, 0x3b, 0x59, 0xd8, 0x4b, 0x67, 0x0b,
-	0x16, 0x51, 0xaf, 0xf8, 0x10, 0x95, 0x48, 0x2d, 0xdd, 0xff, 0x61, 0x42, 0xd5, 0x62, 0x29, 0xf6,
-	0xfb, 0xa1, 0x94, 0xa1, 0x60, 0x1e, 0x1e, 0x4f, 0xb3, 0xb9, 0x17, 0xb0, 0x74, 0x96, 0x70, 0xa5,
-	0x65, 0x52, 0xb4, 0x1e, 0x8f, 0x61, 0x77, 0xcc, 0xf4, 0x42, 0x06, 0x37, 0x4a, 0x73, 0x19, 0xa7,
-	0x6e, 0x1b, 0xea, 0xcb, 0x8c, 0x25, 0x79, 0xa7, 0xd2, 0xaf, 0x0c, 0x9c, 0xab, 0x7f, 0x7e, 0xf1,
-	0xeb, 0x76, 0xa1, 0x19, 0x65, 0x9a, 0x9a, 0xa6, 0x4e, 0xd5, 0x96, 0xd6, 0x27, 0xa3, 0x06, 0xd4,
-	0x74, 0xae, 0xd8, 0xf0, 0x16, 0x1a, 0x45, 0x12, 0xf7, 0x90, 0x14, 0xb3, 0xc9, 0x6a, 0x36, 0xd9,
-	0x98, 0xd3, 0x79, 0x7e, 0xaa, 0xf7, 0x2b, 0x83, 0xd6, 0x49, 0x9b, 0xd8, 0xb0, 0x9b, 0x75, 0xdf,
-	0x7a, 0x86, 0x67, 0x50, 0x4b, 0x1f, 0xb9, 0x72, 0x8f, 0xb6, 0xf8, 0xd2, 0x94, 0x86, 0x6c, 0x25,
-	0x7c, 0x41, 0x61, 0xd3, 0xc7, 0x76, 0x83, 0xc5, 0x34, 0x62, 0xe5, 0xd8, 0x1b, 0x62, 0x8e, 0x8f,
-	0xed, 0x06, 0x33, 0xf7, 0x28, 0xc7, 0x3e, 0x57, 0x18, 0x5e, 0xfb, 0x1c, 0x9c, 0x39, 0x17, 0x6c,
-	0x82, 0x49, 0xbb, 0xbf, 0xd8, 0x4b, 0x2e, 0xd6, 0xe0, 0xab, 0x8d, 0xd9, 0x34, 0xc0, 0x9d, 0x89,
-	0x7a, 0x01, 0xc0, 0x63, 0x95, 0xe9, 0x82, 0xee, 0x6d, 0xa1, 0x99, 0x58, 0xaf, 0xed, 0xdd, 0xe2,
-	0x0e, 0x22, 0xc8, 0x8f, 0x60, 0x47, 0xd1, 0x5c, 0x48, 0x1a, 0xfc, 0xc9, 0xf0, 0x61, 0x0d, 0x2d,
-	0x0b, 0xa1, 0xc3, 0x83, 0x2a, 0x0f, 0xca, 0xc8, 0x2f, 0x4b, 0x56, 0x79, 0x60, 0x42, 0xcf, 0x4d,
-	0x6d, 0x82, 0x5b, 0x2e, 0x01, 0xbf, 0xed, 0xb2, 0x1c, 0x44, 0xae, 0x69, 0xc4, 0x46, 0xbd, 0xfb,
-	0x83, 0x50, 0x12, 0xaa, 0x94, 0xe4, 0xb1, 0xce, 0xc9, 0x4c, 0x46, 0xde, 0x03, 0xa5, 0xc2, 0xbe,
-	0xe3, 0x69, 0x03, 0x45, 0xa7, 0x3f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x92, 0x49, 0x8a, 0xa4, 0xdf,
-	0x02, 0x00, 0x00,
+	// 740 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x95, 0x5b, 0x4f, 0xe3, 0x46,
+	0x14, 0xc7, 0x1b, 0xc8, 0xf5, 0x40, 0x05, 0x0c, 0x15, 0x8a, 0x0a, 0xb4, 0x34, 0xea, 0x03, 0x2f,
+	0x75, 0xa4, 0x22, 0x5e, 0x8c, 0x54, 0xb5, 0x54, 0x54, 0x15, 0x6d, 0x29, 0x32, 0x2b, 0x1e, 0xf6,
+	0x25, 0x9a, 0x38, 0x27, 0x66, 0x36, 0xb6, 0x67, 0x18, 0xdb, 0x88, 0x20, 0xed, 0xe7, 0xe3, 0x23,
+	0xec, 0x47, 0xd8, 0xfb, 0xfd, 0xa6, 0xdd, 0x7d, 0x59, 0xcd, 0xc5, 0x06, 0x44, 0x90, 0x79, 0xca,
+	0x64, 0xce, 0xf9, 0xfd, 0xcf, 0x55, 0x1e, 0x58, 0x4c, 0xfc, 0x23, 0x8c, 0x68, 0xd7, 0xfc, 0x38,
+	0x42, 0xf2, 0x94, 0x93, 0x46, 0x20, 0xa9, 0x38, 0x3a, 0x0e, 0xbf, 0x5f, 0x0b, 0x38, 0x0f, 0x42,
+	0xec, 0xea, 0xeb, 0x7e, 0x36, 0xec, 0x0e, 0x30, 0xf1, 0x25, 0x13, 0x29, 0x97, 0xc6, 0xb5, 0xf3,
+	0xa0, 0x02, 0xdf, 0xfe, 0x87, 0xe9, 0x11, 0x1f, 0xfc, 0x2f, 0x52, 0xc6, 0xe3, 0x84, 0x2c, 0x41,
+	0xed, 0x38, 0x43, 0x39, 0x6e, 0x57, 0xd6, 0x2a, 0xeb, 0xad, 0xbf, 0xbf, 0xf1, 0xcc, 0x5f, 0xb2,
+	0x02, 0xcd, 0x28, 0x4b, 0xa9, 0x72, 0x6a, 0x4f, 0x59, 0x53, 0x71, 0x43, 0x7e, 0x86, 0xd9, 0x24,
+	0xeb, 0x1b, 0x71, 0xe5, 0x31, 0x6d, 0x3d, 0xae, 0xdc, 0x92, 0x0d, 0x00, 0x9f, 0xc7, 0x31, 0xfa,
+	0xda, 0xa7, 0xba, 0x56, 0x59, 0x9f, 0xf9, 0x75, 0xd1, 0xb1, 0xd9, 0x3a, 0x7f, 0x16, 0x26, 0xef,
+	0x92, 0x1b, 0xf9, 0x05, 0xc8, 0x00, 0x85, 0x44, 0x5f, 0x47, 0xea, 0x49, 0xa4, 0x09, 0x8f, 0xdb,
+	0x35, 0x15, 0xc0, 0x5b, 0xb8, 0x64, 0xf1, 0xb4, 0x61, 0xbb, 0x0e, 0xd5, 0x74, 0x2c, 0xb0, 0x73,
+	0x1f, 0xe0, 0x42, 0x90, 0x2c, 0x43, 0x4b, 0xd0, 0x00, 0x7b, 0x09, 0x3b, 0x43, 0x53, 0x99, 0xd7,
+	0x54, 0x17, 0x07, 0xec, 0x0c, 0xc9, 0x2a, 0x80, 0x36, 0xa6, 0x7c, 0x84, 0xb6, 0x38, 0x4f, 0xbb,
+	0xdf, 0x51, 0x17, 0xe4, 0x3b, 0xa8, 0xb1, 0x14, 0xa3, 0xc4, 0x14, 0xe5, 0x99, 0x3f, 0x0a, 0x8a,
+	0xf1, 0x34, 0xb5, 0x50, 0xd5, 0x40, 0xea, 0x46, 0x43, 0x9d, 0x3f, 0xa0, 0xe1, 0x61, 0xc2, 0xc3,
+	0x13, 0x24, 0xf3, 0x30, 0x2d, 0x85, 0x6f, 0xa3, 0xaa, 0xa3, 0xba, 0xa1, 0x32, 0xb0, 0x91, 0xd4,
+	0x91, 0x10, 0xa8, 0xc6, 0x34, 0x42, 0x1b, 0x42, 0x9f, 0x3b, 0x87, 0x50, 0xff, 0x97, 0xd3, 0x01,
+	0xca, 0x09, 0x0a, 0x04, 0xaa, 0x23, 0x1c, 0x27, 0x56, 0x42, 0x9f, 0x6f, 0xc8, 0x73, 0x1e, 0xa6,
+	0x47, 0x38, 0xb6, 0x09, 0xaa, 0xa3, 0xbb, 0x0f, 0x75, 0xb3, 0x2e, 0xe4, 0x07, 0xc7, 0x2c, 0x88,
+	0x93, 0x2f, 0x88, 0x73, 0x65, 0x17, 0xda, 0x0f, 0xcf, 0x6b, 0x7a, 0x46, 0x4b, 0xc5, 0x8c, 0xae,
+	0xd8, 0x3d, 0xab, 0xe3, 0x6e, 0x42, 0x35, 0x19, 0x31, 0x41, 0x7e, 0x9c, 0xa0, 0x97, 0x24, 0x34,
+	0xc0, 0x5c, 0xf0, 0x91, 0x16, 0x6c, 0x7a, 0xda, 0x5d, 0x61, 0xaa, 0xd0, 0x72, 0xec, 0xc9, 0x79,
+	0xed, 0xa2, 0x2f, 0xee, 0xa6, 0x99, 0x70, 0x39, 0xf6, 0x3c, 0xc7, 0x94, 0xbb, 0x8e, 0xc6, 0x07,
+	0xb7, 0xc0, 0x5e, 0xe4, 0x49, 0x2a, 0x77, 0xd7, 0x85, 0x46, 0x80, 0x69, 0x4f, 0x35, 0xbd, 0x94,
+	0x7c, 0x69, 0x03, 0xd6, 0x03, 0x4c, 0x3d, 0xe1, 0xbb, 0xbb, 0x50, 0x0f, 0xcd, 0x04, 0x4b, 0xd1,
+	0x77, 0xb6, 0xd5, 0x73, 0x45, 0xab, 0xcd, 0xec, 0x3d, 0xab, 0xe0, 0x6e, 0x41, 0x6b, 0xc8, 0x42,
+	0xec, 0xe9, 0x46, 0xaf, 0x5c, 0x93, 0xfb, 0x8b, 0x85, 0x85, 0xd6, 0x63, 0x5b, 0x40, 0x53, 0x01,
+	0x07, 0xaa, 0xd3, 0xbb, 0xb0, 0x90, 0xa4, 0x92, 0x89, 0x1e, 0xc6, 0x59, 0xd4, 0x13, 0x12, 0x87,
+	0xec, 0xb4, 0x44, 0xe4, 0xb3, 0x15, 0x99, 0xd3, 0xe0, 0x4e, 0x9c, 0x45, 0xfb, 0x1a, 0x73, 0xb7,
+	0x01, 0x4e, 0x68, 0x98, 0x61, 0x4f, 0xcf, 0xee, 0xa7, 0x6b, 0x22, 0xca, 0xf5, 0x50, 0x39, 0xe4,
+	0x4a, 0x9f, 0x6c, 0x57, 0x5a, 0x1a, 0xdb, 0x53, 0x23, 0x2c, 0x34, 0x74, 0x35, 0xb7, 0xd0, 0xf8,
+	0x62, 0xb3, 0x31, 0x1a, 0xba, 0xa6, 0xdf, 0x00, 0x58, 0x2c, 0xb2, 0xd4, 0x68, 0xac, 0x4e, 0x28,
+	0x06, 0xc3, 0x62, 0x93, 0x9f, 0xe6, 0xbc, 0x46, 0x34, 0xbf, 0x0d, 0xb3, 0x82, 0x8e, 0x55, 0x77,
+	0x6f, 0xa5, 0xf0, 0xcc, 0x2a, 0xcc, 0x58, 0x48, 0x6b, 0x74, 0x61, 0x8a, 0x0d, 0xca, 0xc8, 0x57,
+	0x96, 0x9c, 0x62, 0x03, 0x95, 0xf4, 0x50, 0xd9, 0x4c, 0xf3, 0x4a, 0xc0, 0xd7, 0x79, 0xe3, 0x34,
+	0xa2, 0x1b, 0xf7, 0x3b, 0xcc, 0x44, 0x54, 0x8d, 0x31, 0x95, 0x0c, 0x93, 0x32, 0x81, 0x37, 0x36,
+	0x32, 0x44, 0x54, 0xec, 0x18, 0xc4, 0xfd, 0x07, 0x1a, 0xd2, 0x7e, 0x98, 0x4a, 0xe8, 0xb7, 0x76,
+	0x25, 0xe7, 0x8b, 0x95, 0xb4, 0x5f, 0x34, 0x2f, 0x57, 0x70, 0xb7, 0xa0, 0x29, 0xf1, 0x38, 0x63,
+	0x12, 0x4b, 0xbb, 0xf0, 0x3e, 0x5f, 0xca, 0x1c, 0x50, 0x70, 0x9c, 0x85, 0x21, 0xed, 0x87, 0xa5,
+	0xa9, 0x7c, 0xc8, 0xe1, 0x1c, 0x70, 0xf7, 0x26, 0xbd, 0x0a, 0x65, 0x32, 0x1f, 0xcf, 0x6f, 0x7c,
+	0x36, 0x56, 0xef, 0x2e, 0x07, 0xdc, 0xa1, 0x42, 0x70, 0x16, 0xa7, 0x63, 0xc7, 0xe7, 0x51, 0xf7,
+	0x1e, 0xa5, 0xa1, 0x7d, 0x58, 0xfb, 0x75, 0x2d, 0xb8, 0xf1, 0x75, 0x00, 0x7e, 0x7e, 0x4e, 0x74,
+	0x70, 0x07, 0x00, 0x00,
 }
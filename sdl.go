@@ -0,0 +1,796 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+	"strings"
+	"unicode"
+
+	pgs "github.com/lyft/protoc-gen-star"
+	pbt "go.appointy.com/protoc-gen-jaal/schema"
+)
+
+type SDLField struct {
+	Name        string
+	Args        string
+	Type        string
+	Description string
+	Deprecation string
+}
+
+type SDLType struct {
+	Kind        string
+	Extend      bool
+	Name        string
+	Members     string
+	Implements  string
+	Description string
+	Fields      []SDLField
+	Values      []SDLField
+}
+
+type SDLSchema struct {
+	Scalars []string
+	Types   []SDLType
+}
+
+// rootOperationTypes are the types holding the operations of the schema.
+var rootOperationTypes = map[string]bool{"Query": true, "Mutation": true, "Subscription": true}
+
+// customScalars are the scalars provided by jaal on top of the GraphQL built-in scalars.
+var customScalars = map[string]bool{"Bytes": true, "Duration": true, "FieldMask": true, "Map": true, "Timestamp": true}
+
+func (m *jaalModule) sdlScalar(protoType pgs.ProtoType) string {
+	// maps protoc scalars to graphql scalars
+
+	switch protoType {
+	case pgs.BoolT:
+		return "Boolean"
+	case pgs.StringT:
+		return "String"
+	case pgs.BytesT:
+		return "Bytes"
+	case pgs.FloatT, pgs.DoubleT:
+		return "Float"
+	}
+	return "Int"
+}
+
+func (m *jaalModule) sdlMessageType(message pgs.Message, input bool, PossibleReqObjects map[string]bool) (string, error) {
+	// returns graphql type name of a message as registered by InputType and PayloadType
+
+	switch message.FullyQualifiedName() {
+	case ".google.protobuf.Timestamp":
+		return "Timestamp", nil
+	case ".google.protobuf.Duration":
+		return "Duration", nil
+	case ".google.protobuf.FieldMask":
+		return "FieldMask", nil
+	}
+
+	if jsonType := m.JSONOf(message); jsonType != nil {
+		// free-form messages are Map scalars, Any may be returned as AnyUnion
+		strategy, err := m.AnyStrategy()
+		if err != nil {
+			return "", err
+		}
+		if jsonType.Name == "Any" && strategy == "union" && !input {
+			return "AnyUnion", nil
+		}
+		return "Map", nil
+	}
+
+	if m.WrapperOf(message) != nil {
+		// wrappers are nullable scalars of the type of their value
+		return m.sdlScalar(message.Fields()[0].Type().ProtoType()), nil
+	}
+
+	if input {
+		return m.InputObjectName(message, PossibleReqObjects)
+	}
+	return m.PayloadObjectName(message)
+}
+
+func (m *jaalModule) sdlFieldType(field pgs.Field, input bool, PossibleReqObjects map[string]bool) (string, error) {
+	/*
+		returns graphql type of a field
+		inputs are nullable, required fields are checked by the resolvers, payload scalars are non null unless nullable and payload objects are nullable
+	*/
+
+	if entries, err := m.IsMapEntries(field); err != nil {
+		return "", err
+	} else if entries {
+		entry, err := m.MapEntryOf(field)
+		if err != nil {
+			return "", err
+		}
+		if input {
+			return "[" + entry.InputObjName + "]", nil
+		}
+		return "[" + entry.PayloadObjName + "]!", nil
+	} else if field.Type().IsMap() {
+		if input {
+			return "Map", nil
+		}
+		return "Map", nil
+	}
+
+	idOption, err := m.IdOption(field)
+	if err != nil {
+		return "", err
+	}
+
+	elem := ""
+	nullable := false
+	var protoType pgs.ProtoType
+	if field.Type().IsRepeated() {
+		protoType = field.Type().Element().ProtoType()
+	} else {
+		protoType = field.Type().ProtoType()
+	}
+
+	if strings.ToLower(field.Name().String()) == "id" || idOption {
+		elem = "ID"
+	} else if protoType == pgs.MessageT {
+		var message pgs.Message
+		if field.Type().IsRepeated() {
+			message = field.Type().Element().Embed()
+		} else {
+			message = field.Type().Embed()
+		}
+		if elem, err = m.sdlMessageType(message, input, PossibleReqObjects); err != nil {
+			return "", err
+		}
+		nullable = true
+	} else if protoType == pgs.EnumT {
+		enum := field.Type().Enum()
+		if field.Type().IsRepeated() {
+			enum = field.Type().Element().Enum()
+		}
+		elem = m.EnumTypeName(enum)
+	} else {
+		elem = m.sdlScalar(protoType)
+		nullable = protoType == pgs.BytesT
+	}
+	nullable = nullable || m.IsProto3Optional(field)
+
+	if input {
+		if field.Type().IsRepeated() {
+			elem = "[" + elem + "]"
+		}
+		return elem, nil
+	}
+
+	if option, err := m.IsNullable(field); err != nil {
+		return "", err
+	} else if option {
+		nullable = true
+	}
+
+	if protoType == pgs.EnumT {
+		// hidden values are exposed as null, in lists as null elements
+		enum := field.Type().Enum()
+		if field.Type().IsRepeated() {
+			enum = field.Type().Element().Enum()
+		}
+		hidden, err := m.HiddenValues(enum)
+		if err != nil {
+			return "", err
+		}
+		nullable = nullable || len(hidden) != 0
+	}
+
+	if !nullable {
+		elem += "!"
+	}
+	if field.Type().IsRepeated() {
+		return "[" + elem + "]!", nil
+	}
+	return elem, nil
+}
+
+func (m *jaalModule) sdlMapEntryTypes(message pgs.Message, PossibleReqObjects map[string]bool) ([]SDLType, error) {
+	// returns input and payload object of the entries of the map fields of a message as registered by MapEntryType
+
+	var types []SDLType
+	for _, field := range message.Fields() {
+		if entries, err := m.IsMapEntries(field); err != nil {
+			return nil, err
+		} else if !entries {
+			continue
+		}
+
+		entry, err := m.MapEntryOf(field)
+		if err != nil {
+			return nil, err
+		}
+
+		key := m.sdlScalar(field.Type().Key().ProtoType())
+		value, nullable := "", false
+		element := field.Type().Element()
+		if element.IsEmbed() {
+			if value, err = m.sdlMessageType(element.Embed(), false, PossibleReqObjects); err != nil {
+				return nil, err
+			}
+			nullable = true
+		} else if element.IsEnum() {
+			value = m.EnumTypeName(element.Enum())
+		} else {
+			value = m.sdlScalar(element.ProtoType())
+			nullable = element.ProtoType() == pgs.BytesT
+		}
+		inputValue := value
+		if element.IsEmbed() {
+			if inputValue, err = m.sdlMessageType(element.Embed(), true, PossibleReqObjects); err != nil {
+				return nil, err
+			}
+		}
+		if !nullable {
+			value += "!"
+		}
+
+		types = append(types,
+			SDLType{Kind: "input", Name: entry.InputObjName, Fields: []SDLField{{Name: "key", Type: key}, {Name: "value", Type: inputValue}}},
+			SDLType{Kind: "type", Name: entry.PayloadObjName, Fields: []SDLField{{Name: "key", Type: key + "!"}, {Name: "value", Type: value}}},
+		)
+	}
+
+	return types, nil
+}
+
+func (m *jaalModule) sdlGoType(goType string, elem string) string {
+	// returns the graphql type of elem registered with the go type goType, jaal makes values non null and pointers nullable
+
+	nullable := strings.HasPrefix(goType, "*")
+	goType = strings.TrimPrefix(goType, "*")
+
+	tType := elem
+	if strings.HasPrefix(goType, "[]") {
+		tType = "[" + m.sdlGoType(strings.TrimPrefix(goType, "[]"), elem) + "]"
+	}
+	if !nullable {
+		tType += "!"
+	}
+	return tType
+}
+
+func (m *jaalModule) sdlOperationData(rpc pgs.Method, option pbt.MethodOptions) (*Query, *Mutation, error) {
+	// returns the query, subscription or mutation registered by ServiceInput for rpc, its go types give the nullability of its SDL
+
+	service, err := m.ServiceData(rpc.Service())
+	if err != nil {
+		return nil, nil, err
+	}
+
+	switch {
+	case option.GetMutation() != "":
+		for i := range service.Mutations {
+			if service.Mutations[i].FieldName == option.GetMutation() {
+				return nil, &service.Mutations[i], nil
+			}
+		}
+	case option.GetSubscription() != "":
+		for i := range service.Subscriptions {
+			if service.Subscriptions[i].FieldName == option.GetSubscription() {
+				return &service.Subscriptions[i], nil, nil
+			}
+		}
+	default:
+		for i := range service.Queries {
+			if service.Queries[i].FieldName == option.GetQuery() {
+				return &service.Queries[i], nil, nil
+			}
+		}
+	}
+
+	return nil, nil, fmt.Errorf("%s: operation is not registered by the service", rpc.FullyQualifiedName())
+}
+
+func (m *jaalModule) sdlArgList(rpc pgs.Method, connection *Connection, PossibleReqObjects map[string]bool) ([]SDLField, error) {
+	// returns graphql arguments of a query or subscription as registered by ServiceInput, paging arguments last
+
+	_, option, err := m.GetOption(rpc)
+	if err != nil {
+		return nil, err
+	}
+	query, _, err := m.sdlOperationData(rpc, option)
+	if err != nil {
+		return nil, err
+	}
+	goTypes := make(map[string]string, len(query.InType))
+	for _, arg := range query.InType {
+		goTypes[arg.Name] = arg.Type
+	}
+
+	var args, pageArgs []SDLField
+	for _, field := range rpc.Input().Fields() {
+		if fieldSkip, err := m.GetFieldOptionInput(field); err != nil {
+			return nil, err
+		} else if fieldSkip {
+			continue
+		}
+
+		tType := ""
+		if m.InOneOf(field) {
+			tType = field.Message().Name().LowerCamelCase().String() + field.Name().UpperCamelCase().String()
+		} else {
+			var err error
+			if tType, err = m.sdlFieldType(field, true, PossibleReqObjects); err != nil {
+				return nil, err
+			}
+		}
+
+		elem := strings.Trim(tType, "[]!")
+		if connection != nil && field.Name().UpperCamelCase().String() == connection.PageSize {
+			pageArgs = append([]SDLField{{Name: "first", Type: m.sdlGoType("*"+connection.PageSizeType, elem)}}, pageArgs...)
+			continue
+		} else if connection != nil && field.Name().UpperCamelCase().String() == connection.PageToken {
+			pageArgs = append(pageArgs, SDLField{Name: "after", Type: m.sdlGoType("*string", "String")})
+			continue
+		}
+		if goType, ok := goTypes[field.Name().UpperCamelCase().String()]; ok {
+			tType = m.sdlGoType(goType, elem)
+		}
+		args = append(args, SDLField{Name: field.Name().LowerCamelCase().String(), Type: tType})
+	}
+
+	return append(args, pageArgs...), nil
+}
+
+func (m *jaalModule) sdlArgs(rpc pgs.Method, connection *Connection, PossibleReqObjects map[string]bool) (string, error) {
+	// returns graphql arguments of a query or subscription as written in SDL
+
+	argList, err := m.sdlArgList(rpc, connection, PossibleReqObjects)
+	if err != nil {
+		return "", err
+	}
+
+	if len(argList) == 0 {
+		return "", nil
+	}
+
+	var args []string
+	for _, arg := range argList {
+		args = append(args, arg.Name+": "+arg.Type)
+	}
+	return "(" + strings.Join(args, ", ") + ")", nil
+}
+
+func (m *jaalModule) sdlMessageTypes(message pgs.Message, PossibleReqObjects map[string]bool) ([]SDLType, error) {
+	// returns input and payload object of a message as registered by InputType and PayloadType
+
+	if skip, err := m.GetSkipOption(message); err != nil {
+		return nil, err
+	} else if skip {
+		return nil, nil
+	}
+
+	inputName, err := m.InputObjectName(message, PossibleReqObjects)
+	if err != nil {
+		return nil, err
+	}
+	payloadName, err := m.PayloadObjectName(message)
+	if err != nil {
+		return nil, err
+	}
+	input := SDLType{Kind: "input", Name: inputName, Description: m.Description(message)}
+	payload := SDLType{Kind: "type", Name: payloadName, Description: m.Description(message)}
+	if nodeTypeName, err := m.NodeTypeName(message); err != nil {
+		return nil, err
+	} else if nodeTypeName != "" {
+		payload.Implements = "Node"
+	}
+
+	for _, oneof := range m.OneOfs(message) {
+		for _, field := range oneof.Fields() {
+			if fieldSkip, err := m.GetFieldOptionInput(field); err != nil {
+				return nil, err
+			} else if !fieldSkip {
+				name := oneof.Message().Name().LowerCamelCase().String() + field.Name().UpperCamelCase().String()
+				input.Fields = append(input.Fields, SDLField{Name: name, Type: name, Description: m.Description(field)})
+			}
+		}
+		unionName := "Union" + oneof.Message().Name().UpperCamelCase().String() + oneof.Name().UpperCamelCase().String()
+		payload.Fields = append(payload.Fields, SDLField{Name: oneof.Name().LowerCamelCase().String(), Type: unionName, Description: m.Description(oneof)})
+	}
+
+	for _, field := range m.NonOneOfFields(message) {
+		overrideFieldName, nameToBeOverridden, err := m.getFieldNameOption(field)
+		if err != nil {
+			return nil, err
+		}
+		fieldName := field.Name().LowerCamelCase().String()
+		if overrideFieldName {
+			fieldName = nameToBeOverridden
+		}
+
+		if fieldSkip, err := m.GetFieldOptionInput(field); err != nil {
+			return nil, err
+		} else if !fieldSkip {
+			tType, err := m.sdlFieldType(field, true, PossibleReqObjects)
+			if err != nil {
+				return nil, err
+			}
+			input.Fields = append(input.Fields, SDLField{Name: fieldName, Type: tType, Description: m.Description(field)})
+		}
+
+		if fieldSkip, err := m.GetFieldOptionPayload(field); err != nil {
+			return nil, err
+		} else if !fieldSkip {
+			tType, err := m.sdlFieldType(field, false, PossibleReqObjects)
+			if err != nil {
+				return nil, err
+			}
+			deprecation, err := m.Deprecation(field)
+			if err != nil {
+				return nil, err
+			}
+			payload.Fields = append(payload.Fields, SDLField{Name: fieldName, Type: tType, Description: m.Description(field), Deprecation: deprecation})
+		}
+
+		// resolve option adds the object returned by the rpc, registered by RegisterResolvers
+		if resolver, err := m.ResolverOf(field); err != nil {
+			return nil, err
+		} else if resolver != nil && resolver.Repeated {
+			payload.Fields = append(payload.Fields, SDLField{Name: resolver.FieldName, Type: "[" + resolver.TypeName + "]!", Deprecation: resolver.Deprecation})
+		} else if resolver != nil {
+			payload.Fields = append(payload.Fields, SDLField{Name: resolver.FieldName, Type: resolver.TypeName, Deprecation: resolver.Deprecation})
+		}
+	}
+
+	types := []SDLType{input, payload}
+
+	// message type option registers the same objects once more under the new type name
+	if ok, val, err := m.GetMessageTypeOption(message); err != nil {
+		return nil, err
+	} else if ok {
+		types = append(types, SDLType{Kind: "input", Name: val + "Input", Fields: input.Fields, Description: input.Description}, SDLType{Kind: "type", Name: val, Fields: payload.Fields, Description: payload.Description})
+	}
+
+	return types, nil
+}
+
+func (m *jaalModule) sdlOneofTypes(message pgs.Message, PossibleReqObjects map[string]bool) ([]SDLType, error) {
+	// returns unions and oneof objects of a message as registered by UnionStruct, OneofInputType and OneofPayloadType
+
+	var types []SDLType
+	for _, oneof := range m.OneOfs(message) {
+		var members []string
+		for _, field := range oneof.Fields() {
+			name := field.Message().Name().UpperCamelCase().String() + "_" + field.Name().UpperCamelCase().String()
+			members = append(members, name)
+			fieldName := field.Name().LowerCamelCase().String()
+
+			if fieldSkip, err := m.GetFieldOptionInput(field); err != nil {
+				return nil, err
+			} else if !fieldSkip {
+				tType, err := m.sdlFieldType(field, true, PossibleReqObjects)
+				if err != nil {
+					return nil, err
+				}
+				inputName := field.Message().Name().LowerCamelCase().String() + field.Name().UpperCamelCase().String()
+				types = append(types, SDLType{Kind: "input", Name: inputName, Fields: []SDLField{{Name: fieldName, Type: tType, Description: m.Description(field)}}})
+			}
+
+			if fieldSkip, err := m.GetFieldOptionPayload(field); err != nil {
+				return nil, err
+			} else if !fieldSkip {
+				tType, err := m.sdlFieldType(field, false, PossibleReqObjects)
+				if err != nil {
+					return nil, err
+				}
+				types = append(types, SDLType{Kind: "type", Name: name, Fields: []SDLField{{Name: fieldName, Type: tType, Description: m.Description(field)}}})
+			}
+		}
+		unionName := "Union" + oneof.Message().Name().UpperCamelCase().String() + oneof.Name().UpperCamelCase().String()
+		types = append(types, SDLType{Kind: "union", Name: unionName, Members: strings.Join(members, " | ")})
+	}
+
+	return types, nil
+}
+
+func (m *jaalModule) sdlServiceTypes(service pgs.Service, PossibleReqObjects map[string]bool, operations map[string]*SDLType) ([]SDLType, error) {
+	// returns mutation input and payload objects of a service and adds its rpcs to the operation types
+
+	var types []SDLType
+	for _, rpc := range service.Methods() {
+		flag, option, err := m.GetOption(rpc)
+		if err != nil {
+			return nil, err
+		} else if !flag {
+			continue
+		}
+
+		returnType, err := m.PayloadObjectName(rpc.Output())
+		if err != nil {
+			return nil, err
+		}
+		deprecation, err := m.Deprecation(rpc)
+		if err != nil {
+			return nil, err
+		}
+
+		query, mutation, err := m.sdlOperationData(rpc, option)
+		if err != nil {
+			return nil, err
+		}
+
+		if query != nil {
+			connection, err := m.GetConnection(rpc, option)
+			if err != nil {
+				return nil, err
+			}
+			switch {
+			case connection != nil:
+				returnType = m.sdlGoType("*"+connection.Name, connection.TypeName)
+			case option.GetSubscription() != "":
+				// the values of the channel of a subscription
+				returnType = m.sdlGoType("*"+query.FirstReturnArgType, returnType)
+			default:
+				returnType = m.sdlGoType(query.FirstReturnArgType, returnType)
+			}
+
+			args, err := m.sdlArgs(rpc, connection, PossibleReqObjects)
+			if err != nil {
+				return nil, err
+			}
+			operation, name := operations["Query"], option.GetQuery()
+			if option.GetSubscription() != "" {
+				operation, name = operations["Subscription"], option.GetSubscription()
+			}
+			operation.Fields = append(operation.Fields, SDLField{Name: name, Args: args, Type: returnType, Description: m.Description(rpc), Deprecation: deprecation})
+			continue
+		}
+
+		rpcName := rpc.Name().UpperCamelCase().String()
+		input := SDLType{Kind: "input", Name: rpcName + "Input", Description: m.Description(rpc.Input()), Fields: []SDLField{{Name: "clientMutationId", Type: "String"}}}
+		for _, field := range rpc.Input().Fields() {
+			if fieldSkip, err := m.GetFieldOptionInput(field); err != nil {
+				return nil, err
+			} else if fieldSkip {
+				continue
+			}
+
+			tType := ""
+			if m.InOneOf(field) {
+				tType = field.Message().Name().LowerCamelCase().String() + field.Name().UpperCamelCase().String()
+			} else if tType, err = m.sdlFieldType(field, true, PossibleReqObjects); err != nil {
+				return nil, err
+			}
+			input.Fields = append(input.Fields, SDLField{Name: field.Name().LowerCamelCase().String(), Type: tType, Description: m.Description(field)})
+		}
+
+		payload := SDLType{Kind: "type", Name: rpcName + "Payload", Fields: []SDLField{{Name: "clientMutationId", Type: "String!"}, {Name: "payload", Type: returnType}}}
+		types = append(types, input, payload)
+		args := "(input: " + m.sdlGoType(mutation.InputType, input.Name) + ")"
+		operations["Mutation"].Fields = append(operations["Mutation"].Fields, SDLField{Name: option.GetMutation(), Args: args, Type: m.sdlGoType(mutation.FirstReturnArgType, payload.Name), Description: m.Description(rpc), Deprecation: deprecation})
+	}
+
+	return types, nil
+}
+
+func (m *jaalModule) sdlFileTypes(target pgs.File) ([]SDLType, error) {
+	// returns the graphql types of everything registered by the gq file of target, the root operation types hold its operations
+
+	var types []SDLType
+
+	for _, enumData := range target.AllEnums() {
+		enumType := SDLType{Kind: "enum", Name: m.EnumTypeName(enumData), Description: m.Description(enumData)}
+		for _, val := range enumData.Values() {
+			if hidden, err := m.IsHiddenValue(val); err != nil {
+				return nil, err
+			} else if hidden {
+				continue
+			}
+			name, err := m.EnumValueName(val)
+			if err != nil {
+				return nil, err
+			}
+			deprecation, err := m.Deprecation(val)
+			if err != nil {
+				return nil, err
+			}
+			enumType.Values = append(enumType.Values, SDLField{Name: name, Description: m.Description(val), Deprecation: deprecation})
+		}
+		types = append(types, enumType)
+	}
+
+	PossibleReqObjects := make(map[string]bool)
+	for _, service := range target.Services() {
+		if err := m.getPossibleReqObjects(service, PossibleReqObjects); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, msg := range target.AllMessages() {
+		oneofTypes, err := m.sdlOneofTypes(msg, PossibleReqObjects)
+		if err != nil {
+			return nil, err
+		}
+		messageTypes, err := m.sdlMessageTypes(msg, PossibleReqObjects)
+		if err != nil {
+			return nil, err
+		}
+		entryTypes, err := m.sdlMapEntryTypes(msg, PossibleReqObjects)
+		if err != nil {
+			return nil, err
+		}
+		types = append(types, entryTypes...)
+		types = append(types, oneofTypes...)
+		types = append(types, messageTypes...)
+	}
+
+	operations := map[string]*SDLType{
+		"Query":        {Kind: "type", Name: "Query"},
+		"Mutation":     {Kind: "type", Name: "Mutation"},
+		"Subscription": {Kind: "type", Name: "Subscription"},
+	}
+	for _, service := range target.Services() {
+		serviceTypes, err := m.sdlServiceTypes(service, PossibleReqObjects, operations)
+		if err != nil {
+			return nil, err
+		}
+		types = append(types, serviceTypes...)
+	}
+	for _, operation := range operations {
+		if len(operation.Fields) > 0 {
+			types = append(types, *operation)
+		}
+	}
+
+	return types, nil
+}
+
+func (m *jaalModule) generateSDL(target pgs.File) (string, error) {
+	/*
+		returns the graphql schema (SDL) of everything registered by the gq file of target
+		the operations extend the root operation types declared once per package by jaal.graphql, along with the scalars, so the files of a package can be merged
+	*/
+
+	types, err := m.sdlFileTypes(target)
+	if err != nil {
+		return "", err
+	}
+
+	for i := range types {
+		if rootOperationTypes[types[i].Name] {
+			types[i].Extend = true
+		}
+	}
+
+	return m.executeSDL(nil, types)
+}
+
+func (m *jaalModule) generatePackageSDL(files []pgs.File) (string, error) {
+	// returns the graphql schema (SDL) of the package level registrations, empty if there are none
+
+	nodes, _, err := m.PackageNodes(files)
+	if err != nil {
+		return "", err
+	}
+
+	connections, err := m.PackageConnections(files)
+	if err != nil {
+		return "", err
+	}
+
+	anyMembers, err := m.PackageAnyMembers(files)
+	if err != nil {
+		return "", err
+	}
+
+	// the root operation types and the scalars of the files are declared once for the package
+	roots := make(map[string]*SDLType)
+	var fileTypes []SDLType
+	for _, file := range files {
+		types, err := m.sdlFileTypes(file)
+		if err != nil {
+			return "", err
+		}
+		for _, t := range types {
+			if rootOperationTypes[t.Name] {
+				roots[t.Name] = &SDLType{Kind: "type", Name: t.Name}
+			}
+		}
+		fileTypes = append(fileTypes, types...)
+	}
+
+	var types []SDLType
+	if len(nodes) != 0 {
+		if roots["Query"] == nil {
+			roots["Query"] = &SDLType{Kind: "type", Name: "Query"}
+		}
+		roots["Query"].Fields = []SDLField{
+			{Name: "node", Args: "(id: ID!)", Type: "Node", Description: "Fetches an object given its global id."},
+			{Name: "nodes", Args: "(ids: [ID!]!)", Type: "[Node]!", Description: "Fetches objects given their global ids."},
+		}
+		types = append(types, SDLType{Kind: "interface", Name: "Node", Fields: []SDLField{{Name: "id", Type: "ID!"}}})
+	}
+	for _, root := range roots {
+		types = append(types, *root)
+	}
+
+	if len(connections) != 0 {
+		types = append(types, SDLType{Kind: "type", Name: "PageInfo", Fields: []SDLField{
+			{Name: "hasNextPage", Type: "Boolean!"},
+			{Name: "hasPreviousPage", Type: "Boolean!"},
+			{Name: "startCursor", Type: "String!"},
+			{Name: "endCursor", Type: "String!"},
+		}})
+	}
+
+	for _, connection := range connections {
+		types = append(types,
+			SDLType{Kind: "type", Name: connection.TypeName, Fields: []SDLField{
+				{Name: "edges", Type: "[" + connection.EdgeTypeName + "]!"},
+				{Name: "pageInfo", Type: "PageInfo!"},
+			}},
+			SDLType{Kind: "type", Name: connection.EdgeTypeName, Fields: []SDLField{
+				{Name: "node", Type: connection.NodeTypeName},
+				{Name: "cursor", Type: "String!"},
+			}},
+		)
+	}
+
+	if len(anyMembers) != 0 {
+		var members []string
+		for _, member := range anyMembers {
+			members = append(members, member.TypeName)
+		}
+		types = append(types, SDLType{Kind: "union", Name: "AnyUnion", Members: strings.Join(members, " | ")})
+	}
+
+	scalars := m.sdlScalars(append(fileTypes, types...))
+	if len(types) == 0 && len(scalars) == 0 {
+		return "", nil
+	}
+
+	return m.executeSDL(scalars, types)
+}
+
+func (m *jaalModule) sdlScalars(types []SDLType) []string {
+	// returns the custom scalars used by types, sorted by name
+
+	scalars := make(map[string]bool)
+	for _, t := range types {
+		for _, field := range t.Fields {
+			if name := strings.Trim(field.Type, "[]!"); customScalars[name] {
+				scalars[name] = true
+			}
+			// arguments are written as (name: Type, ...)
+			for _, name := range strings.FieldsFunc(field.Args, func(r rune) bool { return !unicode.IsLetter(r) }) {
+				if customScalars[name] {
+					scalars[name] = true
+				}
+			}
+		}
+	}
+
+	var names []string
+	for scalar := range scalars {
+		names = append(names, scalar)
+	}
+	sort.Strings(names)
+
+	return names
+}
+
+func (m *jaalModule) executeSDL(scalars []string, types []SDLType) (string, error) {
+	// returns SDL of scalars and types, sorted by name
+
+	for _, t := range types {
+		sort.SliceStable(t.Fields, func(i, j int) bool { return t.Fields[i].Name < t.Fields[j].Name })
+	}
+	sort.SliceStable(types, func(i, j int) bool { return types[i].Name < types[j].Name })
+	schema := SDLSchema{Scalars: scalars, Types: types}
+
+	tmp := getSDLTemplate()
+	buf := &bytes.Buffer{}
+
+	if err := tmp.Execute(buf, schema); err != nil {
+		return "", err
+	}
+
+	return buf.String(), nil
+}
@@ -2,6 +2,35 @@ package main
 
 import "text/template"
 import "log"
+import "strconv"
+import "strings"
+
+// templateFuncs are the helper functions available to the templates.
+var templateFuncs = template.FuncMap{
+	"quote": strconv.Quote,
+	// comment returns a description as graphql comment lines
+	"comment": func(description string) string {
+		if description == "" {
+			return ""
+		}
+		return "# " + strings.Replace(description, "\n", "\n# ", -1) + "\n"
+	},
+	// sdlDeprecated returns the deprecated directive of a deprecated field or enum value
+	"sdlDeprecated": func(reason string) string {
+		if reason == "" {
+			return ""
+		}
+		return " @deprecated(reason: " + strconv.Quote(reason) + ")"
+	},
+	// sdlDesc returns a description as graphql block string, indented by indent
+	"sdlDesc": func(indent string, description string) string {
+		if description == "" {
+			return ""
+		}
+		lines := strings.Split(strings.Replace(description, `"""`, `\"""`, -1), "\n")
+		return indent + `"""` + "\n" + indent + strings.Join(lines, "\n"+indent) + "\n" + indent + `"""` + "\n"
+	},
+}
 
 func getEnumTemplate() *template.Template {
 
@@ -14,7 +43,7 @@ func Register{{.Name}}(schema *schemabuilder.Schema){
 }
 `
 
-	t, err := template.New("enum").Parse(tmpl)
+	t, err := template.New("enum").Funcs(templateFuncs).Parse(tmpl)
 	if err != nil {
 		log.Fatal("Parse: ", err)
 		panic(err)
@@ -52,6 +81,10 @@ func RegisterInput{{.Name}}(schema *schemabuilder.Schema) {
 	{{$name:=.Name}}
 	{{range .Maps}}
 		input.FieldFunc("{{.FieldName}}", func(target *{{$name}}, source *schemabuilder.Map) error {
+			if source == nil {
+				// a null map leaves the field unset
+				return nil
+			}
 			v := source.Value
 	
 			decodedValue, err := base64.StdEncoding.DecodeString(v)
@@ -63,21 +96,23 @@ func RegisterInput{{.Name}}(schema *schemabuilder.Schema) {
 			if err := json.Unmarshal(decodedValue, &data); err != nil {
 				return err
 			}
-	
-			target.{{.TargetName}} = data
+	{{if .Wrapper}}
+			target.{{.TargetName}} = make(map[{{.Key}}]*{{.Wrapper}}, len(data))
+			for k, v := range data {
+				target.{{.TargetName}}[k] = &{{.Wrapper}}{}
+				if v != nil {
+					target.{{.TargetName}}[k].Value = *v
+				}
+			}
+	{{else}}
+			target.{{.TargetName}} = data{{end}}
 			return nil
 		}){{end}}
 	{{range .Fields}}
 	input.FieldFunc("{{.FieldName}}", func(target *{{$name}}, source {{.FuncPara}}) {
-		target.{{.TargetName}} = {{.TargetVal}}
-	}){{end}}
-	{{range .Durations}}
-	input.FieldFunc("{{.FieldName}}", func(target *{{$name}}, source []*schemabuilder.Duration) {
-		array := make([]*duration.Duration, 0 ,len(source))
-		for _, s:= range source{
-			array = append(array, (*duration.Duration)(s))
-		}
-		target.{{.Name}} = array
+		{{if .Nullable}}if source != nil {
+			target.{{.TargetName}} = *source
+		}{{else}}target.{{.TargetName}} = {{.TargetVal}}{{end}}
 	}){{end}}
 	{{range .Ids}}
 	input.FieldFunc("{{.FieldName}}", func(target *{{$name}}, source []schemabuilder.ID) {
@@ -87,10 +122,31 @@ func RegisterInput{{.Name}}(schema *schemabuilder.Schema) {
 		}
 		target.{{.Name}}=array
 	}){{end}}
+	{{range .NodeIds}}
+	input.FieldFunc("{{.FieldName}}", func(target *{{$name}}, source *schemabuilder.ID) error {
+		if source == nil || source.Value == "" {
+			return nil
+		}
+		id, err := nodeLocalID("{{.TypeName}}", source.Value)
+		if err != nil {
+			return err
+		}
+		target.{{.Name}} = id
+		return nil
+	}){{end}}
+	{{range .JSONs}}
+	input.FieldFunc("{{.FieldName}}", func(target *{{$name}}, source {{.Type}}) error {
+		value, err := {{.Func}}(source)
+		if err != nil {
+			return err
+		}
+		target.{{.Name}} = value
+		return nil
+	}){{end}}
 }
 `
 
-	t, err := template.New("InputType").Parse(tmpl)
+	t, err := template.New("InputType").Funcs(templateFuncs).Parse(tmpl)
 	if err != nil {
 		log.Fatal("Parse: ", err)
 		panic(err)
@@ -102,10 +158,20 @@ func getPayloadTemplate() *template.Template {
 
 	tmpl := `
 func RegisterPayload{{.Name}}(schema *schemabuilder.Schema) {
-	payload := schema.Object("{{.PayloadObjName}}", {{.Name}}{}){{$name:=.Name}}
+	payload := schema.Object("{{.PayloadObjName}}", {{.Name}}{}){{$name:=.Name}}{{if .Description}}
+	payload.Description = {{quote .Description}}{{end}}
 	{{range .Maps}}
 		payload.FieldFunc("{{.FieldName}}", func(ctx context.Context, in *{{$name}}) (*schemabuilder.Map, error) {
-			data, err := json.Marshal({{.TargetVal}})
+			{{if .Wrapper}}
+			values := make(map[{{.Key}}]{{.Value}}, len({{.TargetVal}}))
+			for k, v := range {{.TargetVal}} {
+				values[k] = nil
+				if v != nil {
+					values[k] = &v.Value
+				}
+			}
+			data, err := json.Marshal(values){{else}}
+			data, err := json.Marshal({{.TargetVal}}){{end}}
 			if err != nil {
 				return nil, err
 			}
@@ -127,15 +193,19 @@ func RegisterPayload{{.Name}}(schema *schemabuilder.Schema) {
 	{{end}}
 	{{range .Fields}}
 	payload.FieldFunc("{{.FieldName}}", func(ctx context.Context, in *{{$name}}) {{.FuncPara}} {
-		return {{.TargetVal}}
-	}){{end}}
-	{{range .Durations}}
-	payload.FieldFunc("{{.FieldName}}", func(ctx context.Context, in *{{$name}}) []*schemabuilder.Duration {
-		array := make([]*schemabuilder.Duration, 0, len(in.{{.Name}}))
-		for _, d := range in.{{.Name}}{
-			array = append(array, (*schemabuilder.Duration)(d))
+		{{if .NullElem}}values := make({{.FuncPara}}, 0, len({{.TargetVal}}))
+		for _, v := range {{.TargetVal}} {
+			if {{.NullElem}} {
+				values = append(values, nil)
+				continue
+			}
+			v := v
+			values = append(values, &v)
 		}
-		return array
+		return values{{else}}{{if .Null}}if {{.Null}} {
+			return nil
+		}
+		{{end}}return {{.TargetVal}}{{end}}
 	}){{end}}
 	{{range .Ids}}
 	payload.FieldFunc("{{.FieldName}}", func(ctx context.Context, in *Class) []schemabuilder.ID {
@@ -145,10 +215,69 @@ func RegisterPayload{{.Name}}(schema *schemabuilder.Schema) {
 		}
 		return array
 	}){{end}}
+	{{range .JSONs}}
+	payload.FieldFunc("{{.FieldName}}", func(ctx context.Context, in *{{$name}}) ({{.Type}}, error) {
+		return {{.Func}}(in.{{.Name}})
+	}){{end}}
+}
+`
+
+	t, err := template.New("Payload").Funcs(templateFuncs).Parse(tmpl)
+	if err != nil {
+		log.Fatal("Parse: ", err)
+		panic(err)
+	}
+
+	return t
+}
+
+func getResolverTemplate() *template.Template {
+
+	tmpl := `
+// RegisterResolvers{{.Name}} registers the fields of {{.Name}} resolved by other rpcs.
+func RegisterResolvers{{.Name}}(schema *schemabuilder.Schema{{range .Clients}}, {{.Name}} {{.Type}}{{end}}, options ...RegisterOption) {
+	config := newCallConfig(options)
+	payload := schema.Object("{{.PayloadObjName}}", {{.Name}}{}){{$name:=.Name}}
+	{{range .Resolvers}}{{$field:=.Name}}{{if .Repeated}}
+	payload.FieldFunc("{{.FieldName}}", func(ctx context.Context, in *{{$name}}) ([]*{{.ReturnType}}, error) {
+		{{with .Loader}}if requestLoaders, ok := ctx.Value(loadersKey{}).(*loaders); ok {
+			return requestLoaders.{{.Field}}.loadAll(ctx, {{.Client}}, in.{{$field}}, config)
+		}
+		{{end}}callCtx, callOptions, err := config.interceptCall(ctx, "{{.FullMethod}}")
+		if err != nil {
+			return nil, err
+		}
+		values := make([]*{{.ReturnType}}, 0, len(in.{{.Name}}))
+		for _, v := range in.{{.Name}} {
+			value, err := {{.Client}}.{{.Method}}(callCtx, &{{.RequestType}}{ {{.Arg}}: v }, callOptions...)
+			if err != nil {
+				return nil, config.mapError(ctx, err)
+			}
+			values = append(values, value)
+		}
+		return values, nil
+	}){{else}}
+	payload.FieldFunc("{{.FieldName}}", func(ctx context.Context, in *{{$name}}) (*{{.ReturnType}}, error) {
+		if in.{{.Name}} == {{.Zero}} {
+			return nil, nil
+		}{{with .Loader}}
+		if requestLoaders, ok := ctx.Value(loadersKey{}).(*loaders); ok {
+			return requestLoaders.{{.Field}}.load(ctx, {{.Client}}, in.{{$field}}, config)
+		}{{end}}
+		callCtx, callOptions, err := config.interceptCall(ctx, "{{.FullMethod}}")
+		if err != nil {
+			return nil, err
+		}
+		value, err := {{.Client}}.{{.Method}}(callCtx, &{{.RequestType}}{ {{.Arg}}: in.{{.Name}} }, callOptions...)
+		if err != nil {
+			return nil, config.mapError(ctx, err)
+		}
+		return value, nil
+	}){{end}}{{end}}
 }
 `
 
-	t, err := template.New("Payload").Parse(tmpl)
+	t, err := template.New("resolver").Funcs(templateFuncs).Parse(tmpl)
 	if err != nil {
 		log.Fatal("Parse: ", err)
 		panic(err)
@@ -160,32 +289,51 @@ func RegisterPayload{{.Name}}(schema *schemabuilder.Schema) {
 func getServiceTemplate() *template.Template {
 
 	tmpl := `
-func Register{{.Name}}Operations(schema *schemabuilder.Schema, client {{.Name}}Client) {
-	{{range .Queries}}
-		schema.Query().FieldFunc("{{.FieldName}}", func(ctx context.Context, args struct {
-		{{range .InType}}
-		{{.Name}} {{.Type}}{{end}}
-		}) ({{.FirstReturnArgType}}, error) {
+{{define "request"}}
+			{{$zero:=.ZeroValue}}
+			{{if .NodeType}}
+			localId := ""
+			if args.{{.NodeIdArg}}.Value != "" {
+				// the id is left out when the object is looked up by another argument
+				id, err := nodeLocalID("{{.NodeType}}", args.{{.NodeIdArg}}.Value)
+				if err != nil {
+					return {{$zero}}, err
+				}
+				localId = id
+			}{{end}}
 			{{range .MapsData}}
-			v{{.Name}} := args.{{.Name}}.Value
-			decodedValue{{.Name}}, err{{.Name}} := base64.StdEncoding.DecodeString(v{{.Name}})
-			if err{{.Name}} != nil {
-				return nil,err{{.Name}}
-			}
-			{{.NewVarName}}Map := make(map[{{.Key}}]{{.Value}})
-			if err{{.Name}} := json.Unmarshal(decodedValue{{.Name}}, &{{.NewVarName}}Map); err{{.Name}} != nil {
-				return nil,err{{.Name}}
+			var {{.NewVarName}}Map map[{{.Key}}]{{if .Wrapper}}*{{.Wrapper}}{{else}}{{.Value}}{{end}}
+			if args.{{.Name}} != nil {
+				// a null map leaves the field unset
+				decodedValue{{.Name}}, err{{.Name}} := base64.StdEncoding.DecodeString(args.{{.Name}}.Value)
+				if err{{.Name}} != nil {
+					return {{$zero}},err{{.Name}}
+				}
+				{{if .Wrapper}}{{.NewVarName}}Values := make(map[{{.Key}}]{{.Value}})
+				if err{{.Name}} := json.Unmarshal(decodedValue{{.Name}}, &{{.NewVarName}}Values); err{{.Name}} != nil {
+					return {{$zero}},err{{.Name}}
+				}
+				{{.NewVarName}}Map = make(map[{{.Key}}]*{{.Wrapper}}, len({{.NewVarName}}Values))
+				for k, v := range {{.NewVarName}}Values {
+					{{.NewVarName}}Map[k] = &{{.Wrapper}}{}
+					if v != nil {
+						{{.NewVarName}}Map[k].Value = *v
+					}
+				}{{else}}{{.NewVarName}}Map = make(map[{{.Key}}]{{.Value}})
+				if err{{.Name}} := json.Unmarshal(decodedValue{{.Name}}, &{{.NewVarName}}Map); err{{.Name}} != nil {
+					return {{$zero}},err{{.Name}}
+				}{{end}}
 			}{{end}}
 			request := &{{.InputName}}{
 			{{range .ReturnType}}
 			{{.Name}}: {{.Type}},{{end}}
 			}
-			{{range .Durations}}
-			array{{.Name}} := make([]*duration.Duration, 0 ,len(args.{{.Name}}))
-			for _, s:= range args.{{.Name}}{
-				array{{.Name}} = append(array{{.Name}}, (*duration.Duration)(s))
+			{{range .JSONs}}
+			json{{.Name}}, err := {{.Func}}(args.{{.Name}})
+			if err != nil {
+				return {{$zero}}, err
 			}
-			request.{{.Name}}=array{{.Name}}
+			request.{{.Name}} = json{{.Name}}
 			{{end}}
 			{{range .Ids}}
 			array{{.Name}} := make([]string,0,len(args.{{.Name}} ))
@@ -202,11 +350,119 @@ func Register{{.Name}}Operations(schema *schemabuilder.Schema, client {{.Name}}C
 					}
 				{{end}}
 			{{end}}
-			response, err := client{{"."}}{{.ReturnFunc}}(ctx, request)
-			if err!= nil{
+			{{range .NullEnums}}
+			if args.{{.}} != nil {
+				request.{{.}} = *args.{{.}}
+			}
+			{{end}}
+{{end}}
+{{define "connection"}}
+			if args.First != nil {
+				request.{{.Connection.PageSize}} = {{if not .Connection.PageSizeRef}}*{{end}}args.First
+			}
+			if args.After != nil {
+				request.{{.Connection.PageToken}} = {{if not .Connection.PageTokenRef}}*{{end}}args.After
+			}
+			{{range .Required}}if {{.Cond}} {
+				return nil, &InputError{Path: "{{.Path}}", Reason: "value is required"}
+			}
+			{{end}}{{if .Validate}}if err := validateRequest(request, "", map[string]string{"{{.Connection.PageSize}}": "first", "{{.Connection.PageToken}}": "after"}); err != nil {
+				return nil, err
+			}
+			{{end}}			callCtx, callOptions, err := config.interceptCall(ctx, "{{.FullMethod}}")
+			if err != nil {
+				return nil, err
+			}
+			response, err := client{{"."}}{{.ReturnFunc}}(callCtx, request, callOptions...)
+			if err != nil {
+				return nil, config.mapError(ctx, err)
+			}
+			// the page tokens are the cursors, every edge of the page is followed by the next page
+			connection := &{{.Connection.Name}}{
+				PageInfo: PageInfo{
+					HasNextPage:     response.{{.Connection.NextToken}} != "",
+					HasPreviousPage: args.After != nil && *args.After != "",
+					EndCursor:       response.{{.Connection.NextToken}},
+				},
+			}
+			if args.After != nil {
+				connection.PageInfo.StartCursor = *args.After
+			}
+			for _, node := range response.{{.Connection.Items}} {
+				connection.Edges = append(connection.Edges, &{{.Connection.EdgeName}}{Node: node, Cursor: response.{{.Connection.NextToken}}})
+			}
+			return connection, nil
+{{end}}
+func Register{{.Name}}Operations(schema *schemabuilder.Schema, client {{.Name}}Client, options ...RegisterOption) {
+	config := newCallConfig(options)
+	{{range .Queries}}
+		schema.Query().FieldFunc("{{.FieldName}}", func(ctx context.Context, args struct {
+		{{range .InType}}
+		{{.Name}} {{.Type}}{{end}}{{if .Connection}}
+		First *{{.Connection.PageSizeType}}
+		After *string{{end}}
+		}) ({{if .Connection}}*{{.Connection.Name}}{{else}}{{.FirstReturnArgType}}{{end}}, error) {
+			{{template "request" .}}
+			{{if .Connection}}{{template "connection" .}}{{else}}{{$zero:=.ZeroValue}}{{range .Required}}
+			if {{.Cond}} {
+				return {{$zero}}, &InputError{Path: "{{.Path}}", Reason: "value is required"}
+			}{{end}}{{if .Validate}}
+			if err := validateRequest(request, "", nil); err != nil {
+				return {{.ZeroValue}}, err
+			}{{end}}
+			callCtx, callOptions, err := config.interceptCall(ctx, "{{.FullMethod}}")
+			if err != nil {
 				return {{.FirstReturnArgType}}{}, err
 			}
-			return *response, nil
+			response, err := client{{"."}}{{.ReturnFunc}}(callCtx, request, callOptions...)
+			if err != nil {
+				return {{.FirstReturnArgType}}{}, config.mapError(ctx, err)
+			}
+			return *response, nil{{end}}
+		})
+	{{end}}
+	{{range .Subscriptions}}
+		schema.Subscription().FieldFunc("{{.FieldName}}", func(ctx context.Context, args struct {
+		{{range .InType}}
+		{{.Name}} {{.Type}}{{end}}
+		}) (<-chan *{{.FirstReturnArgType}}, error) {
+			{{template "request" .}}{{range .Required}}
+			if {{.Cond}} {
+				return nil, &InputError{Path: "{{.Path}}", Reason: "value is required"}
+			}{{end}}{{if .Validate}}
+			if err := validateRequest(request, "", nil); err != nil {
+				return nil, err
+			}{{end}}
+			callCtx, callOptions, err := config.interceptCall(ctx, "{{.FullMethod}}")
+			if err != nil {
+				return nil, err
+			}
+			stream, err := client{{"."}}{{.ReturnFunc}}(callCtx, request, callOptions...)
+			if err != nil {
+				return nil, config.mapError(ctx, err)
+			}
+			out := make(chan *{{.FirstReturnArgType}})
+			go func() {
+				defer close(out)
+				for {
+					response, err := stream.Recv()
+					if err == io.EOF {
+						return
+					} else if err != nil {
+						// the subscription has started, the error of its stream can only be logged
+						if ctx.Err() == nil {
+							log.Printf("subscription {{.FieldName}}: %v", config.mapError(ctx, err))
+						}
+						return
+					}
+					select {
+					case out <- response:
+					case <-ctx.Done():
+						return
+					}
+				}
+			}()
+			return out, nil
 		})
 	{{end}}
 	{{range .Mutations}}
@@ -223,17 +479,30 @@ func Register{{.Name}}Operations(schema *schemabuilder.Schema, client {{.Name}}C
 				if args.Input.{{.Name}} != nil{
 					request.{{$oneOfName}} = args.Input.{{.Name}}
 				}{{end}}{{end}}
-			response, err := client{{"."}}{{.ResponseType}}(ctx, request)
+			{{$return:=.ReturnType}}{{range .Required}}if {{.Cond}} {
+				return {{$return}}{}, &InputError{Path: "{{.Path}}", Reason: "value is required"}
+			}
+			{{end}}{{if .Validate}}if err := validateRequest(request, "input", nil); err != nil {
+				return {{.ReturnType}}{}, err
+			}
+			{{end}}			callCtx, callOptions, err := config.interceptCall(ctx, "{{.FullMethod}}")
+			if err != nil {
+				return {{.ReturnType}}{}, err
+			}
+			response, err := client{{"."}}{{.ResponseType}}(callCtx, request, callOptions...)
+			if err != nil {
+				return {{.ReturnType}}{}, config.mapError(ctx, err)
+			}
 			return {{.ReturnType}}{
 				Payload:          response,
 				ClientMutationId: args.Input.ClientMutationId,
-			}, err
+			}, nil
 		})
 	{{end}}
 }
 `
 
-	t, err := template.New("service").Parse(tmpl)
+	t, err := template.New("service").Funcs(templateFuncs).Parse(tmpl)
 	if err != nil {
 		log.Fatal("Parse: ", err)
 		panic(err)
@@ -286,11 +555,16 @@ type {{.Name}}Payload struct {
 func getInitTemplate() *template.Template {
 
 	tmpl := `
+// {{.Name}} registers the enums, inputs, payloads and unions of {{.File}} on schema.
+func {{.Name}}(schema *schemabuilder.Schema) {
+{{range $key, $value := .Funcs }}
+	{{$key}}(schema){{end}}
+}
+{{if .Init}}
 func init() {
-{{range $key, $value := . }}
-	{{$key}}(gtypes.Schema){{end}}
+	{{.Name}}(gtypes.Schema)
 }
-`
+{{end}}`
 
 	t, err := template.New("Init").Parse(tmpl)
 	if err != nil {
@@ -314,7 +588,7 @@ func RegisterInput{{.Name}}(schema *schemabuilder.Schema) {
 {{end}}
 `
 
-	t, err := template.New("oneof").Parse(tmpl)
+	t, err := template.New("oneof").Funcs(templateFuncs).Parse(tmpl)
 	if err != nil {
 		log.Fatal("Parse: ", err)
 		panic(err)
@@ -336,7 +610,7 @@ func RegisterPayload{{.Name}}(schema *schemabuilder.Schema) {
 {{end}}
 `
 
-	t, err := template.New("oneofPayload").Parse(tmpl)
+	t, err := template.New("oneofPayload").Funcs(templateFuncs).Parse(tmpl)
 	if err != nil {
 		log.Fatal("Parse: ", err)
 		panic(err)
@@ -353,6 +627,10 @@ func RegisterInput{{.Name}}Input(schema *schemabuilder.Schema) {
 	input := schema.InputObject("{{.Name}}Input", {{.Name}}Input{}) {{$name:=.Name}}
 	{{range .Maps}}
 		input.FieldFunc("{{.FieldName}}", func(target *{{$name}}Input, source *schemabuilder.Map) error {
+			if source == nil {
+				// a null map leaves the field unset
+				return nil
+			}
 			v := source.Value
 	
 			decodedValue, err := base64.StdEncoding.DecodeString(v)
@@ -364,23 +642,25 @@ func RegisterInput{{.Name}}Input(schema *schemabuilder.Schema) {
 			if err := json.Unmarshal(decodedValue, &data); err != nil {
 				return err
 			}
-	
-			target.{{.TargetName}} = data
+	{{if .Wrapper}}
+			target.{{.TargetName}} = make(map[{{.Key}}]*{{.Wrapper}}, len(data))
+			for k, v := range data {
+				target.{{.TargetName}}[k] = &{{.Wrapper}}{}
+				if v != nil {
+					target.{{.TargetName}}[k].Value = *v
+				}
+			}
+	{{else}}
+			target.{{.TargetName}} = data{{end}}
 			return nil
 		}){{end}}
 	{{range .Fields}}
 		input.FieldFunc("{{.FieldName}}", func(target *{{$name}}Input, source {{.FuncPara}}) {
-			target{{"."}}{{.TargetName}} = {{.TargetVal}}
+			{{if .Nullable}}if source != nil {
+				target{{"."}}{{.TargetName}} = *source
+			}{{else}}target{{"."}}{{.TargetName}} = {{.TargetVal}}{{end}}
 		})
 	{{end}}
-	{{range .Durations}}
-	input.FieldFunc("{{.Name}}", func(target *{{$name}}Input, source []*schemabuilder.Duration) {
-		array := make([]*duration.Duration, 0 ,len(source))
-		for _, s:= range source{
-			array = append(array, (*duration.Duration)(s))
-		}
-		target.{{.Name}} = array
-	}){{end}}
 	{{range .Ids}}
 	input.FieldFunc("{{.FieldName}}", func(target *{{$name}}Input, source []schemabuilder.ID) {
 		array:= make([]string,0,len(source))
@@ -389,6 +669,27 @@ func RegisterInput{{.Name}}Input(schema *schemabuilder.Schema) {
 		}
 		target.{{.Name}}=array
 	}){{end}}
+	{{range .NodeIds}}
+	input.FieldFunc("{{.FieldName}}", func(target *{{$name}}Input, source *schemabuilder.ID) error {
+		if source == nil || source.Value == "" {
+			return nil
+		}
+		id, err := nodeLocalID("{{.TypeName}}", source.Value)
+		if err != nil {
+			return err
+		}
+		target.{{.Name}} = id
+		return nil
+	}){{end}}
+	{{range .JSONs}}
+	input.FieldFunc("{{.FieldName}}", func(target *{{$name}}Input, source {{.Type}}) error {
+		value, err := {{.Func}}(source)
+		if err != nil {
+			return err
+		}
+		target.{{.Name}} = value
+		return nil
+	}){{end}}
 	input.FieldFunc("clientMutationId", func(target *{{.Name}}Input, source string) {
 		target.ClientMutationId = source
 	})
@@ -396,7 +697,7 @@ func RegisterInput{{.Name}}Input(schema *schemabuilder.Schema) {
 {{end}}
 `
 
-	t, err := template.New("inputMutationStruct").Parse(tmpl)
+	t, err := template.New("inputMutationStruct").Funcs(templateFuncs).Parse(tmpl)
 	if err != nil {
 		log.Fatal("Parse: ", err)
 		panic(err)
@@ -421,7 +722,835 @@ func getServiceStructPayloadFuncTemplate() *template.Template {
 {{end}}
 `
 
-	t, err := template.New("inputMutationStruct").Parse(tmpl)
+	t, err := template.New("inputMutationStruct").Funcs(templateFuncs).Parse(tmpl)
+	if err != nil {
+		log.Fatal("Parse: ", err)
+		panic(err)
+	}
+
+	return t
+}
+
+func getMapEntryTemplate() *template.Template {
+
+	tmpl := `
+{{range .}}
+// {{.Name}} is a key value entry of a map field.
+type {{.Name}} struct {
+	Key   {{.Key}}
+	Value {{.Value}}
+}
+
+// {{.Name}}Map returns the map of a list of {{.Name}}, a later entry overrides an earlier entry with the same key.
+func {{.Name}}Map(entries []*{{.Name}}) map[{{.Key}}]{{.Value}} {
+	data := make(map[{{.Key}}]{{.Value}}, len(entries))
+	for _, e := range entries {
+		if e != nil {
+			data[e.Key] = e.Value
+		}
+	}
+	return data
+}
+
+// {{.Name}}List returns the entries of a map sorted by key.
+func {{.Name}}List(data map[{{.Key}}]{{.Value}}) []*{{.Name}} {
+	entries := make([]*{{.Name}}, 0, len(data))
+	for k, v := range data {
+		entries = append(entries, &{{.Name}}{Key: k, Value: v})
+	}
+	sort.Slice(entries, func(i, j int) bool {
+		return {{if eq .Key "bool"}}!entries[i].Key && entries[j].Key{{else}}entries[i].Key < entries[j].Key{{end}}
+	})
+	return entries
+}
+
+func RegisterPayload{{.Name}}(schema *schemabuilder.Schema) {
+	payload := schema.Object("{{.PayloadObjName}}", {{.Name}}{})
+	payload.FieldFunc("key", func(ctx context.Context, in *{{.Name}}) {{.Key}} {
+		return in.Key
+	})
+	payload.FieldFunc("value", func(ctx context.Context, in *{{.Name}}) {{if .PayloadErr}}({{.PayloadType}}, error){{else}}{{.PayloadType}}{{end}} {
+		return {{.PayloadVal}}
+	})
+}
+
+func RegisterInput{{.Name}}(schema *schemabuilder.Schema) {
+	input := schema.InputObject("{{.InputObjName}}", {{.Name}}{})
+	input.FieldFunc("key", func(target *{{.Name}}, source {{.Key}}) {
+		target.Key = source
+	})
+	input.FieldFunc("value", func(target *{{.Name}}, source {{.InputType}}) {{if .InputErr}}error {
+		value, err := {{.InputVal}}
+		if err != nil {
+			return err
+		}
+		target.Value = value
+		return nil
+	}{{else}}{
+		target.Value = {{.InputVal}}
+	}{{end}})
+}
+{{end}}
+`
+
+	t, err := template.New("MapEntry").Parse(tmpl)
+	if err != nil {
+		log.Fatal("Parse: ", err)
+		panic(err)
+	}
+
+	return t
+}
+
+func getSDLTemplate() *template.Template {
+
+	tmpl := `# Code generated by protoc-gen-jaal. DO NOT EDIT.
+{{range .Scalars}}
+scalar {{.}}
+{{end}}{{range .Types}}
+{{sdlDesc "" .Description}}{{if eq .Kind "union"}}union {{.Name}} = {{.Members}}
+{{else}}{{if .Extend}}extend {{end}}{{.Kind}} {{.Name}}{{if .Implements}} implements {{.Implements}}{{end}}{{if or .Fields .Values}} {
+{{range .Fields}}{{sdlDesc "    " .Description}}    {{.Name}}{{.Args}}: {{.Type}}{{sdlDeprecated .Deprecation}}
+{{end}}{{range .Values}}{{sdlDesc "    " .Description}}    {{.Name}}{{sdlDeprecated .Deprecation}}
+{{end}}}{{end}}
+{{end}}{{end}}`
+
+	t, err := template.New("sdl").Funcs(templateFuncs).Parse(tmpl)
+	if err != nil {
+		log.Fatal("Parse: ", err)
+		panic(err)
+	}
+
+	return t
+}
+
+func getOperationsTemplate() *template.Template {
+
+	tmpl := `# Code generated by protoc-gen-jaal. DO NOT EDIT.
+{{range .}}
+{{comment .Description}}{{.Kind}} {{.Name}}{{.Variables}} {
+    {{.Field}}{{.Args}} {{.Selection}}
+}
+{{end}}`
+
+	t, err := template.New("operations").Funcs(templateFuncs).Parse(tmpl)
+	if err != nil {
+		log.Fatal("Parse: ", err)
+		panic(err)
+	}
+
+	return t
+}
+
+func getPackageTemplate() *template.Template {
+
+	tmpl := `// Code generated by protoc-gen-graphql. DO NOT EDIT.
+package {{.Package}}
+
+import ({{range .StdImports}}
+	"{{.}}"{{end}}{{if and .StdImports .Imports}}
+{{end}}{{range .Imports}}
+	{{if .Name}}{{.Name}} {{end}}"{{.Path}}"{{end}}
+)
+{{if .Nodes}}
+// Node is the relay Node interface, implemented by every node of the package.
+type Node struct {
+	schemabuilder.Interface
+	{{range .Nodes}}
+	*{{.Name}}{{end}}
+}
+
+// encodeGlobalID returns the global id of a node from its type name and id.
+func encodeGlobalID(typeName string, id string) string {
+	return base64.StdEncoding.EncodeToString([]byte(typeName + ":" + id))
+}
+
+// decodeGlobalID returns the type name and id of a node from its global id.
+func decodeGlobalID(globalID string) (string, string, error) {
+	decoded, err := base64.StdEncoding.DecodeString(globalID)
+	if err != nil {
+		return "", "", fmt.Errorf("invalid id %q", globalID)
+	}
+
+	parts := strings.SplitN(string(decoded), ":", 2)
+	if len(parts) != 2 {
+		return "", "", fmt.Errorf("invalid id %q", globalID)
+	}
+
+	return parts[0], parts[1], nil
+}
+
+// nodeLocalID returns the id of a node of type typeName from its global id.
+func nodeLocalID(typeName string, globalID string) (string, error) {
+	name, id, err := decodeGlobalID(globalID)
+	if err != nil {
+		return "", err
+	}
+
+	if name != typeName {
+		return "", fmt.Errorf("invalid %s id %q", typeName, globalID)
+	}
+
+	return id, nil
+}
+
+func RegisterNodeOperations(schema *schemabuilder.Schema{{range .Clients}}, {{.Name}} {{.Type}}{{end}}, options ...RegisterOption) {
+	config := newCallConfig(options)
+	resolve := func(ctx context.Context, id schemabuilder.ID) (*Node, error) {
+		typeName, localId, err := decodeGlobalID(id.Value)
+		if err != nil {
+			return nil, err
+		}
+
+		switch typeName {
+		{{range .Nodes}}
+		case "{{.TypeName}}":
+			callCtx, callOptions, err := config.interceptCall(ctx, "{{.FullMethod}}")
+			if err != nil {
+				return nil, err
+			}
+			response, err := {{.Client}}.{{.Method}}(callCtx, &{{.RequestType}}{ {{.IdField}}: localId }, callOptions...)
+			if err != nil {
+				return nil, config.mapError(ctx, err)
+			}
+			return &Node{ {{.Name}}: response }, nil
+		{{end}}
+		}
+
+		return nil, fmt.Errorf("unknown node type %s", typeName)
+	}
+
+	schema.Query().FieldFunc("node", func(ctx context.Context, args struct {
+		Id schemabuilder.ID
+	}) (*Node, error) {
+		return resolve(ctx, args.Id)
+	})
+
+	schema.Query().FieldFunc("nodes", func(ctx context.Context, args struct {
+		Ids []schemabuilder.ID
+	}) ([]*Node, error) {
+		nodes := make([]*Node, 0, len(args.Ids))
+		for _, id := range args.Ids {
+			node, err := resolve(ctx, id)
+			if err != nil {
+				return nil, err
+			}
+			nodes = append(nodes, node)
+		}
+		return nodes, nil
+	})
+}
+{{end}}
+{{if .Connections}}
+// PageInfo is the relay page info of a connection, StartCursor is the token of the page and EndCursor the token of the next page.
+type PageInfo struct {
+	HasNextPage     bool
+	HasPreviousPage bool
+	StartCursor     string
+	EndCursor       string
+}
+
+func RegisterPayloadPageInfo(schema *schemabuilder.Schema) {
+	payload := schema.Object("PageInfo", PageInfo{})
+	payload.FieldFunc("hasNextPage", func(ctx context.Context, in *PageInfo) bool {
+		return in.HasNextPage
+	})
+	payload.FieldFunc("hasPreviousPage", func(ctx context.Context, in *PageInfo) bool {
+		return in.HasPreviousPage
+	})
+	payload.FieldFunc("startCursor", func(ctx context.Context, in *PageInfo) string {
+		return in.StartCursor
+	})
+	payload.FieldFunc("endCursor", func(ctx context.Context, in *PageInfo) string {
+		return in.EndCursor
+	})
+}
+{{range .Connections}}
+type {{.Name}} struct {
+	Edges    []*{{.EdgeName}}
+	PageInfo PageInfo
+}
+
+type {{.EdgeName}} struct {
+	Node   *{{.NodeType}}
+	Cursor string
+}
+
+func RegisterPayload{{.Name}}(schema *schemabuilder.Schema) {
+	payload := schema.Object("{{.TypeName}}", {{.Name}}{})
+	payload.FieldFunc("edges", func(ctx context.Context, in *{{.Name}}) []*{{.EdgeName}} {
+		return in.Edges
+	})
+	payload.FieldFunc("pageInfo", func(ctx context.Context, in *{{.Name}}) PageInfo {
+		return in.PageInfo
+	})
+}
+
+func RegisterPayload{{.EdgeName}}(schema *schemabuilder.Schema) {
+	payload := schema.Object("{{.EdgeTypeName}}", {{.EdgeName}}{})
+	payload.FieldFunc("node", func(ctx context.Context, in *{{.EdgeName}}) *{{.NodeType}} {
+		return in.Node
+	})
+	payload.FieldFunc("cursor", func(ctx context.Context, in *{{.EdgeName}}) string {
+		return in.Cursor
+	})
+}
+{{end}}
+// registerPackageTypes registers the connections of the package on schema.
+func registerPackageTypes(schema *schemabuilder.Schema) {
+	RegisterPayloadPageInfo(schema){{range .Connections}}
+	RegisterPayload{{.Name}}(schema)
+	RegisterPayload{{.EdgeName}}(schema){{end}}
+}
+{{if .Init}}
+func init() {
+	registerPackageTypes(gtypes.Schema)
+}
+{{end}}{{end}}
+// RegisterTypes registers the types of all the files of the package on schema.
+func RegisterTypes(schema *schemabuilder.Schema) { {{range .Files}}
+	{{.}}(schema){{end}}{{if .Connections}}
+	registerPackageTypes(schema){{end}}
+}
+{{if .Resolvers}}
+// RegisterResolvers registers the fields of the package resolved by other rpcs, it takes the client of every service referred by a resolve option.
+func RegisterResolvers(schema *schemabuilder.Schema{{range .ResolverClients}}, {{.Name}} {{.Type}}{{end}}, options ...RegisterOption) { {{range .Resolvers}}
+	RegisterResolvers{{.Name}}(schema{{range .Clients}}, {{.Name}}{{end}}, options...){{end}}
+}
+{{end}}{{if .Calls}}
+// CallInterceptor prepares an rpc called by the operations, nodes and resolved fields of the package. It returns the
+// context of the call, e.g. holding outgoing metadata, and the options appended to the call. method is the full name
+// of the rpc, e.g. /customer.Customers/GetCustomer.
+type CallInterceptor func(ctx context.Context, method string) (context.Context, []grpc.CallOption, error)
+
+// ForwardedHeaders are the headers of the GraphQL request forwarded as metadata by the operations, nodes and resolved
+// fields registered without interceptors.
+var ForwardedHeaders = []string{"Authorization", "X-Request-Id", "Traceparent", "Tracestate"}
+
+type headersKey struct{}
+
+// WithHeaders returns a context holding the headers of the GraphQL request, e.g. the Header of its http.Request, to
+// be forwarded by ForwardHeaders.
+func WithHeaders(ctx context.Context, header map[string][]string) context.Context {
+	return context.WithValue(ctx, headersKey{}, header)
+}
+
+// ForwardHeaders returns a CallInterceptor appending the headers named by names, held by the context as set by
+// WithHeaders, to the outgoing metadata of the call. Names are matched case insensitively and sent in lower case.
+func ForwardHeaders(names ...string) CallInterceptor {
+	return func(ctx context.Context, method string) (context.Context, []grpc.CallOption, error) {
+		header, _ := ctx.Value(headersKey{}).(map[string][]string)
+
+		var pairs []string
+		for _, name := range names {
+			for key, values := range header {
+				if !strings.EqualFold(key, name) {
+					continue
+				}
+				for _, value := range values {
+					pairs = append(pairs, strings.ToLower(name), value)
+				}
+			}
+		}
+
+		if len(pairs) == 0 {
+			return ctx, nil, nil
+		}
+		return metadata.AppendToOutgoingContext(ctx, pairs...), nil, nil
+	}
+}
+
+// RegisterOption configures the rpcs called by the operations, nodes and resolved fields registered with it.
+type RegisterOption func(*callConfig)
+
+// WithInterceptors returns a RegisterOption preparing the rpcs with interceptors, in order, instead of forwarding the
+// headers named by ForwardedHeaders.
+func WithInterceptors(interceptors ...CallInterceptor) RegisterOption {
+	return func(config *callConfig) {
+		config.interceptors = append(config.interceptors, interceptors...)
+	}
+}
+
+// WithErrorMapper returns a RegisterOption mapping the errors of the rpcs to the errors returned to the GraphQL client
+// with mapper instead of StatusError, e.g. to hide the message of internal errors.
+func WithErrorMapper(mapper func(ctx context.Context, err error) error) RegisterOption {
+	return func(config *callConfig) {
+		config.mapError = mapper
+	}
+}
+
+// callConfig holds the interceptors and the error mapper of the rpcs called by one registration.
+type callConfig struct {
+	interceptors []CallInterceptor
+	mapError     func(ctx context.Context, err error) error
+}
+
+// newCallConfig returns the configuration set by options, the forwarding of ForwardedHeaders and StatusError by default.
+func newCallConfig(options []RegisterOption) *callConfig {
+	config := &callConfig{}
+	for _, option := range options {
+		option(config)
+	}
+
+	if len(config.interceptors) == 0 {
+		config.interceptors = []CallInterceptor{func(ctx context.Context, method string) (context.Context, []grpc.CallOption, error) {
+			return ForwardHeaders(ForwardedHeaders...)(ctx, method)
+		}}
+	}
+	if config.mapError == nil {
+		config.mapError = StatusError
+	}
+
+	return config
+}
+
+// interceptCall returns the context and the options of the call of method, prepared by the interceptors in order.
+func (c *callConfig) interceptCall(ctx context.Context, method string) (context.Context, []grpc.CallOption, error) {
+	var options []grpc.CallOption
+	for _, interceptor := range c.interceptors {
+		callCtx, callOptions, err := interceptor(ctx, method)
+		if err != nil {
+			return nil, nil, err
+		}
+		ctx = callCtx
+		options = append(options, callOptions...)
+	}
+
+	return ctx, options, nil
+}
+
+// GraphQLError is an error returned with extensions, e.g. {"code": "NOT_FOUND"}, in the errors of the GraphQL response.
+type GraphQLError struct {
+	Message string
+	Data    map[string]interface{}
+}
+
+func (e *GraphQLError) Error() string {
+	return e.Message
+}
+
+// Extensions returns the extensions of the GraphQL error.
+func (e *GraphQLError) Extensions() map[string]interface{} {
+	return e.Data
+}
+
+// statusCodes are the codes of gRPC statuses as named by google.rpc.Code.
+var statusCodes = map[codes.Code]string{
+	codes.OK:                 "OK",
+	codes.Canceled:           "CANCELLED",
+	codes.Unknown:            "UNKNOWN",
+	codes.InvalidArgument:    "INVALID_ARGUMENT",
+	codes.DeadlineExceeded:   "DEADLINE_EXCEEDED",
+	codes.NotFound:           "NOT_FOUND",
+	codes.AlreadyExists:      "ALREADY_EXISTS",
+	codes.PermissionDenied:   "PERMISSION_DENIED",
+	codes.ResourceExhausted:  "RESOURCE_EXHAUSTED",
+	codes.FailedPrecondition: "FAILED_PRECONDITION",
+	codes.Aborted:            "ABORTED",
+	codes.OutOfRange:         "OUT_OF_RANGE",
+	codes.Unimplemented:      "UNIMPLEMENTED",
+	codes.Internal:           "INTERNAL",
+	codes.Unavailable:        "UNAVAILABLE",
+	codes.DataLoss:           "DATA_LOSS",
+	codes.Unauthenticated:    "UNAUTHENTICATED",
+}
+
+// StatusError returns a GraphQLError for the gRPC status of err, other errors are returned unchanged. The code of the
+// status is the code extension, and its BadRequest, ErrorInfo, ResourceInfo and RetryInfo details are the
+// fieldViolations, reason, domain, metadata, resource and retryDelay extensions.
+func StatusError(ctx context.Context, err error) error {
+	st, ok := status.FromError(err)
+	if !ok {
+		return err
+	}
+
+	code, ok := statusCodes[st.Code()]
+	if !ok {
+		code = statusCodes[codes.Unknown]
+	}
+	extensions := map[string]interface{}{"code": code}
+
+	for _, detail := range st.Details() {
+		switch d := detail.(type) {
+		case *errdetails.BadRequest:
+			violations := make([]map[string]interface{}, 0, len(d.FieldViolations))
+			for _, v := range d.FieldViolations {
+				violations = append(violations, map[string]interface{}{"field": v.Field, "description": v.Description})
+			}
+			extensions["fieldViolations"] = violations
+		case *errdetails.ErrorInfo:
+			extensions["reason"] = d.Reason
+			extensions["domain"] = d.Domain
+			if len(d.Metadata) != 0 {
+				extensions["metadata"] = d.Metadata
+			}
+		case *errdetails.ResourceInfo:
+			extensions["resource"] = map[string]interface{}{"type": d.ResourceType, "name": d.ResourceName, "owner": d.Owner, "description": d.Description}
+		case *errdetails.RetryInfo:
+			if delay, err := ptypes.Duration(d.RetryDelay); err == nil {
+				extensions["retryDelay"] = delay.String()
+			}
+		}
+	}
+
+	return &GraphQLError{Message: st.Message(), Data: extensions}
+}
+{{end}}{{if or .Validate .Required}}
+// InputError is the error of an argument without a value for a required field or rejected by the validation rules of
+// protoc-gen-validate, returned before the rpc is called.
+type InputError struct {
+	// Path is the path of the rejected field in the arguments, e.g. input.email or addresses[0].city
+	Path   string
+	Reason string
+}
+
+func (e *InputError) Error() string {
+	return fmt.Sprintf("invalid %s: %s", e.Path, e.Reason)
+}
+
+// Extensions returns the extensions of the GraphQL error, the rejected field is a field violation as for the BadRequest
+// of an INVALID_ARGUMENT status.
+func (e *InputError) Extensions() map[string]interface{} {
+	return map[string]interface{}{
+		"code":            "INVALID_ARGUMENT",
+		"fieldViolations": []map[string]interface{}{ {"field": e.Path, "description": e.Reason} },
+	}
+}
+{{end}}{{if .Validate}}
+// validationError is implemented by the errors of the Validate methods generated by protoc-gen-validate.
+type validationError interface {
+	Field() string
+	Reason() string
+	Cause() error
+}
+
+// validateRequest validates a request with its Validate method generated by protoc-gen-validate, if any. The rejected
+// field is reported as an InputError with its path in the arguments under prefix, args renames the request fields
+// exposed as other arguments.
+func validateRequest(request interface{}, prefix string, args map[string]string) error {
+	v, ok := request.(interface{ Validate() error })
+	if !ok {
+		return nil
+	}
+
+	err := v.Validate()
+	if err == nil {
+		return nil
+	}
+
+	var path []string
+	if prefix != "" {
+		path = append(path, prefix)
+	}
+
+	// the errors of embedded messages are the cause of the error of their field
+	reason := err.Error()
+	for e, ok := err.(validationError); ok; e, ok = e.Cause().(validationError) {
+		field := e.Field()
+		if name, renamed := args[field]; renamed {
+			field = name
+		} else if field != "" {
+			field = strings.ToLower(field[:1]) + field[1:]
+		}
+		args = nil
+		path = append(path, field)
+		reason = e.Reason()
+	}
+
+	return &InputError{Path: strings.Join(path, "."), Reason: reason}
+}
+{{end}}{{if .Loaders}}
+// LoaderWait is the time a loader of the package waits for more keys before calling its batch rpc.
+var LoaderWait = time.Millisecond
+
+type loadersKey struct{}
+
+// loaders holds the loaders of the package for one request.
+type loaders struct { {{range .Loaders}}
+	{{.Field}} {{.Field}}Loader{{end}}
+}
+
+// WithLoaders returns a context holding new loaders of the package. The fields resolved with it by the rpc of a
+// message with a loader are batched into one call of the loader rpc per LoaderWait, without it they call their rpc
+// one by one. It is expected once per request, e.g. in the http handler of the graphql server, the batch calls are
+// made with the context of the first field of each batch, holding the headers of the request as the other calls.
+func WithLoaders(ctx context.Context) context.Context {
+	return context.WithValue(ctx, loadersKey{}, &loaders{})
+}
+{{range .Loaders}}
+// {{.Field}}Batch is the keys of {{.Name}} loaded by one {{.Method}} call.
+type {{.Field}}Batch struct {
+	ctx   context.Context
+	{{.Client}} {{.ClientType}}
+	keys  []{{.KeyType}}
+	done  chan struct{}
+	items map[{{.KeyType}}]*{{.Name}}
+	err   error
+}
+
+// {{.Field}}Loader batches the loads of {{.Name}} of a request into {{.Method}} calls made with the context of their
+// first load, the loads of each registration are batched apart with its client and options.
+type {{.Field}}Loader struct {
+	mu      sync.Mutex
+	batches map[*callConfig]*{{.Field}}Batch
+}
+
+// load returns the {{.Name}} of a key, nil if it is not returned by {{.Method}}.
+func (l *{{.Field}}Loader) load(ctx context.Context, {{.Client}} {{.ClientType}}, key {{.KeyType}}, config *callConfig) (*{{.Name}}, error) {
+	items, err := l.loadAll(ctx, {{.Client}}, []{{.KeyType}}{key}, config)
+	if err != nil {
+		return nil, err
+	}
+	return items[0], nil
+}
+
+// loadAll returns the {{.Name}} of each key, nil for a key not returned by {{.Method}}.
+func (l *{{.Field}}Loader) loadAll(ctx context.Context, {{.Client}} {{.ClientType}}, keys []{{.KeyType}}, config *callConfig) ([]*{{.Name}}, error) {
+	if len(keys) == 0 {
+		return []*{{.Name}}{}, nil
+	}
+
+	l.mu.Lock()
+	batch := l.batches[config]
+	if batch == nil {
+		// the first key of a batch schedules its call with its context, later keys join it until then
+		batch = &{{.Field}}Batch{ctx: ctx, {{.Client}}: {{.Client}}, done: make(chan struct{})}
+		if l.batches == nil {
+			l.batches = make(map[*callConfig]*{{.Field}}Batch)
+		}
+		l.batches[config] = batch
+		time.AfterFunc(LoaderWait, func() {
+			l.mu.Lock()
+			delete(l.batches, config)
+			l.mu.Unlock()
+			l.fetch(batch, config)
+		})
+	}
+	batch.keys = append(batch.keys, keys...)
+	l.mu.Unlock()
+
+	select {
+	case <-batch.done:
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+	if batch.err != nil {
+		return nil, batch.err
+	}
+
+	items := make([]*{{.Name}}, 0, len(keys))
+	for _, key := range keys {
+		items = append(items, batch.items[key])
+	}
+	return items, nil
+}
+
+// fetch calls {{.Method}} with the distinct keys of a batch and releases its loads.
+func (l *{{.Field}}Loader) fetch(batch *{{.Field}}Batch, config *callConfig) {
+	defer close(batch.done)
+
+	keys := make([]{{.KeyType}}, 0, len(batch.keys))
+	seen := make(map[{{.KeyType}}]bool, len(batch.keys))
+	for _, key := range batch.keys {
+		if !seen[key] {
+			seen[key] = true
+			keys = append(keys, key)
+		}
+	}
+
+	callCtx, callOptions, err := config.interceptCall(batch.ctx, "{{.FullMethod}}")
+	if err != nil {
+		batch.err = err
+		return
+	}
+
+	response, err := batch.{{.Client}}.{{.Method}}(callCtx, &{{.RequestType}}{ {{.Keys}}: keys }, callOptions...)
+	if err != nil {
+		batch.err = config.mapError(batch.ctx, err)
+		return
+	}
+
+	batch.items = make(map[{{.KeyType}}]*{{.Name}}, len(response.{{.Items}}))
+	for _, item := range response.{{.Items}} {
+		if item != nil {
+			batch.items[item.{{.Key}}] = item
+		}
+	}
+}
+{{end}}{{end}}
+{{range .Wrappers}}
+// wrap{{.Name}} returns the {{.Name}} of a nullable {{.Type}}.
+func wrap{{.Name}}(v *{{.Type}}) *{{.GoType}} {
+	if v == nil {
+		return nil
+	}
+	return &{{.GoType}}{Value: {{if eq .ValueType "[]byte"}}v.Value{{else}}*v{{end}}}
+}
+
+// wrap{{.Name}}s returns the {{.Name}} list of a list of nullable {{.Type}}, null elements are wrapped as zero values.
+func wrap{{.Name}}s(values []*{{.Type}}) []*{{.GoType}} {
+	wrapped := make([]*{{.GoType}}, 0, len(values))
+	for _, v := range values {
+		if v == nil {
+			wrapped = append(wrapped, &{{.GoType}}{})
+			continue
+		}
+		wrapped = append(wrapped, wrap{{.Name}}(v))
+	}
+	return wrapped
+}
+
+// unwrap{{.Name}} returns the nullable {{.Type}} of a {{.Name}}.
+func unwrap{{.Name}}(v *{{.GoType}}) *{{.Type}} {
+	if v == nil {
+		return nil
+	}
+	return {{if eq .ValueType "[]byte"}}&schemabuilder.Bytes{Value: v.Value}{{else}}&v.Value{{end}}
+}
+
+// unwrap{{.Name}}s returns the list of nullable {{.Type}} of a {{.Name}} list.
+func unwrap{{.Name}}s(values []*{{.GoType}}) []*{{.Type}} {
+	unwrapped := make([]*{{.Type}}, 0, len(values))
+	for _, v := range values {
+		unwrapped = append(unwrapped, unwrap{{.Name}}(v))
+	}
+	return unwrapped
+}
+{{end}}
+{{range .WKTs}}
+// toProto{{.Name}} returns the {{.GoType}} of a schemabuilder.{{.Name}}.
+func toProto{{.Name}}(v *schemabuilder.{{.Name}}) *{{.GoType}} {
+	if v == nil {
+		return nil
+	}
+	return &{{.GoType}}{Seconds: v.Seconds, Nanos: v.Nanos}
+}
+
+// toProto{{.Name}}s returns the {{.GoType}} list of a schemabuilder.{{.Name}} list.
+func toProto{{.Name}}s(values []*schemabuilder.{{.Name}}) []*{{.GoType}} {
+	converted := make([]*{{.GoType}}, 0, len(values))
+	for _, v := range values {
+		converted = append(converted, toProto{{.Name}}(v))
+	}
+	return converted
+}
+
+// fromProto{{.Name}} returns the schemabuilder.{{.Name}} of a {{.GoType}}.
+func fromProto{{.Name}}(v *{{.GoType}}) *schemabuilder.{{.Name}} {
+	if v == nil {
+		return nil
+	}
+	return &schemabuilder.{{.Name}}{Seconds: v.Seconds, Nanos: v.Nanos}
+}
+
+// fromProto{{.Name}}s returns the schemabuilder.{{.Name}} list of a {{.GoType}} list.
+func fromProto{{.Name}}s(values []*{{.GoType}}) []*schemabuilder.{{.Name}} {
+	converted := make([]*schemabuilder.{{.Name}}, 0, len(values))
+	for _, v := range values {
+		converted = append(converted, fromProto{{.Name}}(v))
+	}
+	return converted
+}
+{{end}}
+{{range .JSONTypes}}
+// unmarshal{{.Name}} returns the {{.Name}} of a Map scalar, holding the base64 encoded JSON of the {{.Name}}.
+func unmarshal{{.Name}}(v *schemabuilder.Map) (*{{.GoType}}, error) {
+	if v == nil {
+		return nil, nil
+	}
+	data, err := base64.StdEncoding.DecodeString(v.Value)
+	if err != nil {
+		return nil, err
+	}
+	value := &{{.GoType}}{}
+	if err := jsonpb.UnmarshalString(string(data), value); err != nil {
+		return nil, err
+	}
+	return value, nil
+}
+
+// unmarshal{{.Name}}s returns the {{.Name}} list of a list of Map scalars, null elements are unmarshalled as zero values.
+func unmarshal{{.Name}}s(values []*schemabuilder.Map) ([]*{{.GoType}}, error) {
+	unmarshalled := make([]*{{.GoType}}, 0, len(values))
+	for _, v := range values {
+		value, err := unmarshal{{.Name}}(v)
+		if err != nil {
+			return nil, err
+		}
+		if value == nil {
+			value = &{{.GoType}}{}
+		}
+		unmarshalled = append(unmarshalled, value)
+	}
+	return unmarshalled, nil
+}
+
+// marshal{{.Name}} returns the Map scalar of a {{.Name}}, holding its base64 encoded JSON.
+func marshal{{.Name}}(v *{{.GoType}}) (*schemabuilder.Map, error) {
+	if v == nil {
+		return nil, nil
+	}
+	data, err := (&jsonpb.Marshaler{}).MarshalToString(v)
+	if err != nil {
+		return nil, err
+	}
+	return &schemabuilder.Map{Value: base64.StdEncoding.EncodeToString([]byte(data))}, nil
+}
+
+// marshal{{.Name}}s returns the list of Map scalars of a {{.Name}} list.
+func marshal{{.Name}}s(values []*{{.GoType}}) ([]*schemabuilder.Map, error) {
+	marshalled := make([]*schemabuilder.Map, 0, len(values))
+	for _, v := range values {
+		value, err := marshal{{.Name}}(v)
+		if err != nil {
+			return nil, err
+		}
+		marshalled = append(marshalled, value)
+	}
+	return marshalled, nil
+}
+{{end}}
+{{if .AnyMembers}}
+// AnyUnion is the union of the messages of the package, returned for google.protobuf.Any.
+type AnyUnion struct {
+	schemabuilder.Union
+	{{range .AnyMembers}}
+	*{{.Name}}{{end}}
+}
+
+// anyUnion returns the AnyUnion holding the message packed in an Any.
+func anyUnion(v *{{.AnyType}}) (*AnyUnion, error) {
+	if v == nil {
+		return nil, nil
+	}
+	var message ptypes.DynamicAny
+	if err := ptypes.UnmarshalAny(v, &message); err != nil {
+		return nil, err
+	}
+	switch m := message.Message.(type) {
+	{{range .AnyMembers}}
+	case *{{.Name}}:
+		return &AnyUnion{ {{.Name}}: m }, nil{{end}}
+	}
+	return nil, fmt.Errorf("type %s is not a member of AnyUnion", v.TypeUrl)
+}
+
+// anyUnions returns the AnyUnion list of an Any list.
+func anyUnions(values []*{{.AnyType}}) ([]*AnyUnion, error) {
+	unions := make([]*AnyUnion, 0, len(values))
+	for _, v := range values {
+		union, err := anyUnion(v)
+		if err != nil {
+			return nil, err
+		}
+		unions = append(unions, union)
+	}
+	return unions, nil
+}
+{{end}}
+`
+
+	t, err := template.New("package").Parse(tmpl)
 	if err != nil {
 		log.Fatal("Parse: ", err)
 		panic(err)
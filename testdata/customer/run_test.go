@@ -0,0 +1,61 @@
+package customerpb
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"google.golang.org/grpc"
+)
+
+// batchClient answers BatchGetCustomers with a customer for each id.
+type batchClient struct {
+	CustomersClient
+}
+
+func (c *batchClient) BatchGetCustomers(ctx context.Context, in *BatchGetCustomersRequest, opts ...grpc.CallOption) (*BatchGetCustomersResponse, error) {
+	response := &BatchGetCustomersResponse{}
+	for _, id := range in.Ids {
+		response.Customers = append(response.Customers, &Customer{Id: id})
+	}
+	return response, nil
+}
+
+func TestLoaderHeaders(t *testing.T) {
+	// the batch call is intercepted with the headers of the request, stored by the handler after WithLoaders
+
+	var mu sync.Mutex
+	var authorizations []string
+	config := newCallConfig([]RegisterOption{WithInterceptors(func(ctx context.Context, method string) (context.Context, []grpc.CallOption, error) {
+		header, _ := ctx.Value(headersKey{}).(map[string][]string)
+		mu.Lock()
+		authorizations = append(authorizations, header["Authorization"]...)
+		mu.Unlock()
+		return ctx, nil, nil
+	})})
+
+	ctx := WithHeaders(WithLoaders(context.Background()), map[string][]string{"Authorization": {"Bearer token"}})
+	requestLoaders := ctx.Value(loadersKey{}).(*loaders)
+
+	var wg sync.WaitGroup
+	client := &batchClient{}
+	for _, id := range []string{"1", "2"} {
+		wg.Add(1)
+		go func(id string) {
+			defer wg.Done()
+			customer, err := requestLoaders.customer.load(ctx, client, id, config)
+			if err != nil {
+				t.Error(err)
+				return
+			}
+			if customer.GetId() != id {
+				t.Errorf("expected customer %s, got %v", id, customer)
+			}
+		}(id)
+	}
+	wg.Wait()
+
+	if len(authorizations) != 1 || authorizations[0] != "Bearer token" {
+		t.Errorf("expected one batch call with the authorization of the request, got %v", authorizations)
+	}
+}
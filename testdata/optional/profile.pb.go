@@ -0,0 +1,49 @@
+// The types and the client of profile.proto generated by protoc-gen-go and protoc-gen-go-grpc of
+// google.golang.org/protobuf, trimmed to the API used by the generated code. The protoc-gen-go of golang/protobuf
+// v1.3.1 the tests run predates proto3 optional, the optional fields are pointers in this output only.
+
+package profilepb
+
+import (
+	context "context"
+
+	grpc "google.golang.org/grpc"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+type Visibility int32
+
+const (
+	Visibility_VISIBILITY_UNSPECIFIED Visibility = 0
+	Visibility_PUBLIC                 Visibility = 1
+	Visibility_PRIVATE                Visibility = 2
+)
+
+func (x Visibility) Enum() *Visibility {
+	p := new(Visibility)
+	*p = x
+	return p
+}
+
+type Profile struct {
+	Id         string
+	Nickname   *string
+	Age        *int32
+	Visibility *Visibility
+	Picture    []byte
+}
+
+type GetProfileRequest struct {
+	Id       string
+	Verified *bool
+}
+
+type UpdateProfileRequest struct {
+	Profile    *Profile
+	UpdateMask *fieldmaskpb.FieldMask
+}
+
+type ProfilesClient interface {
+	GetProfile(ctx context.Context, in *GetProfileRequest, opts ...grpc.CallOption) (*Profile, error)
+	UpdateProfile(ctx context.Context, in *UpdateProfileRequest, opts ...grpc.CallOption) (*Profile, error)
+}
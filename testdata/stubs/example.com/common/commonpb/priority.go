@@ -0,0 +1,11 @@
+// Package commonpb stubs the go package of common/priority.proto of the enums fixture, imported by the output of
+// protoc-gen-go and protoc-gen-jaal.
+package commonpb
+
+type Priority int32
+
+const (
+	Priority_PRIORITY_UNSPECIFIED Priority = 0
+	Priority_LOW                  Priority = 1
+	Priority_HIGH                 Priority = 2
+)
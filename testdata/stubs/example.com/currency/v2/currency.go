@@ -0,0 +1,11 @@
+// Package v2 stubs the go package of currency/currency.proto of the events fixture, protoc-gen-go names it after the
+// major version of its path.
+package v2
+
+type Currency int32
+
+const (
+	Currency_CURRENCY_UNSPECIFIED Currency = 0
+	Currency_USD                  Currency = 1
+	Currency_EUR                  Currency = 2
+)
@@ -0,0 +1,2 @@
+// Package validate stubs the go package of validate/validate.proto, imported by the output of protoc-gen-go.
+package validate
@@ -0,0 +1,11 @@
+// Package gtypes stubs the API of go.appointy.com/jaal/gtypes used by the generated code.
+package gtypes
+
+import (
+	"go.appointy.com/jaal/schemabuilder"
+	"google.golang.org/genproto/protobuf/field_mask"
+)
+
+var Schema = schemabuilder.NewSchema()
+
+func ModifyFieldMask(mask *field_mask.FieldMask) *field_mask.FieldMask { return mask }
@@ -0,0 +1,2 @@
+// Package schema stubs the go package of schema/schema.proto, imported by the output of protoc-gen-go.
+package schema
@@ -0,0 +1,52 @@
+// Package schemabuilder stubs the API of go.appointy.com/jaal/schemabuilder used by the generated code,
+// so the golden files can be type-checked without the jaal module.
+package schemabuilder
+
+type Schema struct{}
+
+func NewSchema() *Schema { return &Schema{} }
+
+func (s *Schema) Query() *Object        { return &Object{} }
+func (s *Schema) Mutation() *Object     { return &Object{} }
+func (s *Schema) Subscription() *Object { return &Object{} }
+
+func (s *Schema) Object(name string, typ interface{}) *Object { return &Object{Name: name} }
+
+func (s *Schema) InputObject(name string, typ interface{}) *InputObject {
+	return &InputObject{Name: name}
+}
+
+func (s *Schema) Enum(val interface{}, enumMap interface{}) {}
+
+type Object struct {
+	Name        string
+	Description string
+}
+
+func (o *Object) FieldFunc(name string, f interface{}) {}
+
+type InputObject struct {
+	Name string
+}
+
+func (io *InputObject) FieldFunc(name string, f interface{}) {}
+
+type Union struct{}
+
+type Interface struct{}
+
+type ID struct{ Value string }
+
+type Bytes struct{ Value []byte }
+
+type Map struct{ Value string }
+
+type Timestamp struct {
+	Seconds int64
+	Nanos   int32
+}
+
+type Duration struct {
+	Seconds int64
+	Nanos   int32
+}
@@ -0,0 +1,2 @@
+// Package annotations stubs the go package of google/api/field_behavior.proto, imported by the output of protoc-gen-go.
+package annotations
@@ -0,0 +1,30 @@
+// Package errdetails stubs the error details of google.golang.org/genproto used by the generated code.
+package errdetails
+
+import "github.com/golang/protobuf/ptypes/duration"
+
+type BadRequest struct {
+	FieldViolations []*BadRequest_FieldViolation
+}
+
+type BadRequest_FieldViolation struct {
+	Field       string
+	Description string
+}
+
+type ErrorInfo struct {
+	Reason   string
+	Domain   string
+	Metadata map[string]string
+}
+
+type ResourceInfo struct {
+	ResourceType string
+	ResourceName string
+	Owner        string
+	Description  string
+}
+
+type RetryInfo struct {
+	RetryDelay *duration.Duration
+}
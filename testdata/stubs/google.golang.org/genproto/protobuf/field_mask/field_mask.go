@@ -0,0 +1,7 @@
+// Package field_mask stubs the FieldMask of google.golang.org/genproto, an alias of the FieldMask of
+// google.golang.org/protobuf.
+package field_mask
+
+import "google.golang.org/protobuf/types/known/fieldmaskpb"
+
+type FieldMask = fieldmaskpb.FieldMask
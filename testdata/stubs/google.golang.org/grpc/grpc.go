@@ -0,0 +1,66 @@
+// Package grpc stubs the API of google.golang.org/grpc used by the output of the grpc plugin of protoc-gen-go.
+package grpc
+
+import "context"
+
+const SupportPackageIsVersion4 = true
+
+type CallOption interface{}
+
+type ClientConn struct{}
+
+func (cc *ClientConn) Invoke(ctx context.Context, method string, args, reply interface{}, opts ...CallOption) error {
+	return nil
+}
+
+func (cc *ClientConn) NewStream(ctx context.Context, desc *StreamDesc, method string, opts ...CallOption) (ClientStream, error) {
+	return nil, nil
+}
+
+type ClientStream interface {
+	CloseSend() error
+	SendMsg(m interface{}) error
+	RecvMsg(m interface{}) error
+}
+
+type ServerStream interface {
+	SendMsg(m interface{}) error
+	RecvMsg(m interface{}) error
+}
+
+type Server struct{}
+
+func (s *Server) RegisterService(sd *ServiceDesc, ss interface{}) {}
+
+type UnaryServerInfo struct {
+	Server     interface{}
+	FullMethod string
+}
+
+type UnaryHandler func(ctx context.Context, req interface{}) (interface{}, error)
+
+type UnaryServerInterceptor func(ctx context.Context, req interface{}, info *UnaryServerInfo, handler UnaryHandler) (interface{}, error)
+
+type methodHandler func(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor UnaryServerInterceptor) (interface{}, error)
+
+type MethodDesc struct {
+	MethodName string
+	Handler    methodHandler
+}
+
+type StreamHandler func(srv interface{}, stream ServerStream) error
+
+type StreamDesc struct {
+	StreamName    string
+	Handler       StreamHandler
+	ServerStreams bool
+	ClientStreams bool
+}
+
+type ServiceDesc struct {
+	ServiceName string
+	HandlerType interface{}
+	Methods     []MethodDesc
+	Streams     []StreamDesc
+	Metadata    interface{}
+}
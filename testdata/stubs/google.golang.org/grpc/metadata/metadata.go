@@ -0,0 +1,6 @@
+// Package metadata stubs the API of google.golang.org/grpc/metadata used by the generated code.
+package metadata
+
+import "context"
+
+func AppendToOutgoingContext(ctx context.Context, kv ...string) context.Context { return ctx }
@@ -0,0 +1,14 @@
+// Package status stubs the API of google.golang.org/grpc/status used by the generated code.
+package status
+
+import "google.golang.org/grpc/codes"
+
+type Status struct{}
+
+func (s *Status) Code() codes.Code { return codes.OK }
+
+func (s *Status) Message() string { return "" }
+
+func (s *Status) Details() []interface{} { return nil }
+
+func FromError(err error) (*Status, bool) { return nil, false }
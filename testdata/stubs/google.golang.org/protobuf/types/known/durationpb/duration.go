@@ -0,0 +1,7 @@
+// Package durationpb stubs the Duration of google.golang.org/protobuf.
+package durationpb
+
+type Duration struct {
+	Seconds int64
+	Nanos   int32
+}
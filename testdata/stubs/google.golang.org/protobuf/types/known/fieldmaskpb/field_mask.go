@@ -0,0 +1,6 @@
+// Package fieldmaskpb stubs the FieldMask of google.golang.org/protobuf.
+package fieldmaskpb
+
+type FieldMask struct {
+	Paths []string
+}
@@ -0,0 +1,25 @@
+// Package structpb stubs the Struct, Value and ListValue of google.golang.org/protobuf, they are messages of
+// golang/protobuf, unmarshalled by jsonpb.
+package structpb
+
+type Struct struct {
+	Fields map[string]*Value
+}
+
+func (*Struct) Reset()         {}
+func (*Struct) String() string { return "" }
+func (*Struct) ProtoMessage()  {}
+
+type Value struct{}
+
+func (*Value) Reset()         {}
+func (*Value) String() string { return "" }
+func (*Value) ProtoMessage()  {}
+
+type ListValue struct {
+	Values []*Value
+}
+
+func (*ListValue) Reset()         {}
+func (*ListValue) String() string { return "" }
+func (*ListValue) ProtoMessage()  {}
@@ -0,0 +1,7 @@
+// Package timestamppb stubs the Timestamp of google.golang.org/protobuf.
+package timestamppb
+
+type Timestamp struct {
+	Seconds int64
+	Nanos   int32
+}
@@ -0,0 +1,337 @@
+package main
+
+import (
+	"fmt"
+	"path"
+	"sort"
+	"strings"
+
+	pgs "github.com/lyft/protoc-gen-star"
+)
+
+// validator collects the diagnostics of the targets registered on one graphql schema.
+type validator struct {
+	m           *jaalModule
+	types       map[string]pgs.Entity
+	operations  map[string]map[string]pgs.Entity
+	diagnostics []string
+
+	// names of the types generated for the packages, graphql names and go names prefixed by the go package, and of the generated queries
+	generated        map[string]string
+	generatedGo      map[string]string
+	generatedQueries map[string]string
+}
+
+func (m *jaalModule) Validate(targets map[string]pgs.File) []string {
+	/*
+		checks the targets before anything is generated and returns the sorted diagnostics of the inputs the generated code can not register
+		all the targets are registered on one schema, so names are checked across files and packages
+	*/
+
+	v := &validator{
+		m:     m,
+		types: make(map[string]pgs.Entity),
+		operations: map[string]map[string]pgs.Entity{
+			"query":        make(map[string]pgs.Entity),
+			"mutation":     make(map[string]pgs.Entity),
+			"subscription": make(map[string]pgs.Entity),
+		},
+		generated:        make(map[string]string),
+		generatedGo:      make(map[string]string),
+		generatedQueries: make(map[string]string),
+	}
+
+	var names []string
+	for name := range targets {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	packages := make(map[string][]pgs.File)
+	var files []pgs.File
+	for _, name := range names {
+		if ok, err := m.CheckSkipFile(targets[name]); err != nil || ok {
+			// errors are reported with the file
+			continue
+		}
+		v.packageFiles(targets[name], targets)
+		pkg := m.GetGoPackage(targets[name])
+		packages[pkg] = append(packages[pkg], targets[name])
+		files = append(files, targets[name])
+	}
+
+	// the generated types are known before the files, they are reserved for any file claiming their names
+	for pkg, pkgFiles := range packages {
+		v.packageTypes(pkg, pkgFiles)
+	}
+
+	for _, file := range files {
+		v.file(file)
+	}
+
+	// a field colliding on both the input and the payload is reported once
+	sort.Strings(v.diagnostics)
+	var diagnostics []string
+	for i, diagnostic := range v.diagnostics {
+		if i == 0 || diagnostic != v.diagnostics[i-1] {
+			diagnostics = append(diagnostics, diagnostic)
+		}
+	}
+
+	return diagnostics
+}
+
+func (v *validator) report(entity pgs.Entity, format string, args ...interface{}) {
+	v.diagnostics = append(v.diagnostics, fmt.Sprintf("%s: %s: %s", entity.File().Name(), entity.FullyQualifiedName(), fmt.Sprintf(format, args...)))
+}
+
+func (v *validator) check(entity pgs.Entity, err error) bool {
+	// reports err, returns true if there is none
+	if err != nil {
+		v.diagnostics = append(v.diagnostics, fmt.Sprintf("%s: %v", entity.File().Name(), err))
+		return false
+	}
+	return true
+}
+
+func (v *validator) claim(names map[string]pgs.Entity, kind, name string, entity pgs.Entity) {
+	// records entity as owner of name, reports a collision if name is already owned by another entity
+
+	if owner, ok := names[name]; ok && owner.FullyQualifiedName() != entity.FullyQualifiedName() {
+		v.report(entity, "%s %s is already used by %s (%s)", kind, name, owner.FullyQualifiedName(), owner.File().Name())
+		return
+	}
+	names[name] = entity
+}
+
+func (v *validator) claimType(name string, entity pgs.Entity) {
+	// claims a graphql type name, reports a collision with a generated type as well
+
+	if generated, ok := v.generated[name]; ok {
+		v.report(entity, "type %s is already generated for %s", name, generated)
+		return
+	}
+	v.claim(v.types, "type", name, entity)
+}
+
+func (v *validator) packageFiles(target pgs.File, targets map[string]pgs.File) {
+	/*
+		reports a target generated without the other files of its package, named after the files generated for the package,
+		or without the name of a go package ending with a major version
+		jaal.pb.gq.go and jaal.graphql hold the helpers and types of all the files of the package, another run would overwrite them
+	*/
+
+	if path.Base(target.Name().String()) == "jaal.proto" {
+		v.diagnostics = append(v.diagnostics, fmt.Sprintf("%s: the files of the package are generated as jaal.pb.gq.go and jaal.graphql, the file must be renamed", target.Name()))
+	}
+
+	// protoc-gen-go names the package after the major version of a module path, e.g. v2, the generated code after the element before it
+	if goPackage := target.Descriptor().GetOptions().GetGoPackage(); !strings.Contains(goPackage, ";") && strings.Contains(goPackage, "/") && majorVersionRegexp.MatchString(path.Base(goPackage)) {
+		importPath, name := v.m.GoImport(target, "")
+		v.diagnostics = append(v.diagnostics, fmt.Sprintf("%s: go_package %s ends with a major version, its package name must be given after ;, e.g. %s;%s", target.Name(), importPath, importPath, name))
+	}
+
+	for _, file := range target.Package().Files() {
+		if _, ok := targets[file.Name().String()]; !ok {
+			v.diagnostics = append(v.diagnostics, fmt.Sprintf("%s: %s of package %s must be generated along with it, jaal.pb.gq.go is generated from all the files of the package", target.Name(), file.Name(), target.Package().ProtoName()))
+		}
+	}
+}
+
+func (v *validator) packageTypes(pkg string, files []pgs.File) {
+	// records the types generated once for the files of a go package, errors are reported with the files
+
+	reserve := func(name, goName, generated string) {
+		v.generated[name] = generated
+		v.generatedGo[pkg+"."+goName] = generated
+	}
+
+	if nodes, _, err := v.m.PackageNodes(files); err == nil && len(nodes) != 0 {
+		reserve("Node", "Node", "the nodes of package "+pkg)
+		// the node queries are added by RegisterNodeOperations
+		v.generatedQueries["node"] = "the nodes of package " + pkg
+		v.generatedQueries["nodes"] = "the nodes of package " + pkg
+	}
+
+	if connections, err := v.m.PackageConnections(files); err == nil {
+		for _, connection := range connections {
+			generated := "the connections of " + connection.NodeTypeName
+			reserve("PageInfo", "PageInfo", "the connections of package "+pkg)
+			reserve(connection.TypeName, connection.Name, generated)
+			reserve(connection.EdgeTypeName, connection.EdgeName, generated)
+		}
+	}
+
+	if members, err := v.m.PackageAnyMembers(files); err == nil && len(members) != 0 {
+		reserve("AnyUnion", "AnyUnion", "google.protobuf.Any in package "+pkg)
+	}
+}
+
+func (v *validator) goType(entity pgs.Entity) {
+	// reports the go type of a message or enum colliding with a type generated in its go package
+
+	name := v.m.Context.Name(entity).String()
+	if generated, ok := v.generatedGo[v.m.GetGoPackage(entity.File())+"."+name]; ok {
+		v.report(entity, "go type %s is already generated for %s", name, generated)
+	}
+}
+
+func (v *validator) file(target pgs.File) {
+	PossibleReqObjects := make(map[string]bool)
+	for _, service := range target.Services() {
+		if !v.check(service, v.m.getPossibleReqObjects(service, PossibleReqObjects)) {
+			return
+		}
+	}
+
+	for _, enum := range target.AllEnums() {
+		v.goType(enum)
+		v.claimType(v.m.EnumTypeName(enum), enum)
+
+		values := make(map[string]pgs.Entity)
+		numbers := make(map[int32]bool)
+		for _, value := range enum.Values() {
+			numbers[value.Value()] = true
+			// hidden values are not registered, their names are free
+			if hidden, err := v.m.IsHiddenValue(value); !v.check(value, err) || hidden {
+				continue
+			}
+			if name, err := v.m.EnumValueName(value); v.check(value, err) {
+				v.claim(values, "value", name, value)
+			}
+		}
+		if hidden, err := v.m.HiddenValues(enum); v.check(enum, err) && len(hidden) == len(numbers) {
+			v.report(enum, "every value of the enum is skipped, a GraphQL enum needs a value")
+		}
+	}
+
+	for _, message := range target.AllMessages() {
+		v.goType(message)
+		v.message(message, PossibleReqObjects)
+	}
+
+	for _, service := range target.Services() {
+		for _, rpc := range service.Methods() {
+			v.rpc(rpc)
+		}
+	}
+}
+
+func (v *validator) message(message pgs.Message, PossibleReqObjects map[string]bool) {
+	// checks the objects and fields registered for a message
+
+	for _, field := range message.Fields() {
+		if _, err := v.m.IdOption(field); !v.check(field, err) {
+			continue
+		}
+
+		_, err := v.m.IsRequired(field)
+		v.check(field, err)
+		_, err = v.m.IsNullable(field)
+		v.check(field, err)
+		_, err = v.m.Deprecation(field)
+		v.check(field, err)
+
+		// entries are registered for skipped messages as well
+		if entries, err := v.m.IsMapEntries(field); !v.check(field, err) || !entries {
+			continue
+		}
+		if entry, err := v.m.MapEntryOf(field); v.check(field, err) {
+			v.claimType(entry.PayloadObjName, field)
+			v.claimType(entry.InputObjName, field)
+		}
+	}
+
+	// loaders are generated for skipped messages as well
+	_, err := v.m.LoaderOf(message)
+	v.check(message, err)
+
+	if skip, err := v.m.GetSkipOption(message); !v.check(message, err) || skip {
+		return
+	}
+
+	if name, err := v.m.PayloadObjectName(message); v.check(message, err) {
+		v.claimType(name, message)
+	}
+	if name, err := v.m.InputObjectName(message, PossibleReqObjects); v.check(message, err) {
+		v.claimType(name, message)
+	}
+
+	// input and payload fields are checked separately, oneofs are a single payload field but one input field per member
+	inputFields := make(map[string]pgs.Entity)
+	payloadFields := make(map[string]pgs.Entity)
+
+	for _, oneof := range v.m.OneOfs(message) {
+		v.claimType("Union"+message.Name().UpperCamelCase().String()+oneof.Name().UpperCamelCase().String(), oneof)
+		v.claim(payloadFields, "field", oneof.Name().LowerCamelCase().String(), oneof)
+
+		for _, field := range oneof.Fields() {
+			v.claimType(message.Name().UpperCamelCase().String()+"_"+field.Name().UpperCamelCase().String(), field)
+
+			name := message.Name().LowerCamelCase().String() + field.Name().UpperCamelCase().String()
+			if fieldSkip, err := v.m.GetFieldOptionInput(field); v.check(field, err) && !fieldSkip {
+				v.claimType(name, field)
+				v.claim(inputFields, "field", name, field)
+			}
+		}
+	}
+
+	for _, field := range v.m.NonOneOfFields(message) {
+		override, fieldName, err := v.m.getFieldNameOption(field)
+		if !v.check(field, err) {
+			continue
+		} else if !override {
+			fieldName = field.Name().LowerCamelCase().String()
+		}
+
+		if fieldSkip, err := v.m.GetFieldOptionInput(field); v.check(field, err) && !fieldSkip {
+			v.claim(inputFields, "field", fieldName, field)
+		}
+		if fieldSkip, err := v.m.GetFieldOptionPayload(field); v.check(field, err) && !fieldSkip {
+			v.claim(payloadFields, "field", fieldName, field)
+		}
+		if resolver, err := v.m.ResolverOf(field); v.check(field, err) && resolver != nil {
+			v.claim(payloadFields, "field", resolver.FieldName, field)
+		}
+	}
+}
+
+func (v *validator) rpc(rpc pgs.Method) {
+	// checks the operation and the mutation objects registered for an rpc
+
+	flag, option, err := v.m.GetOption(rpc)
+	if !v.check(rpc, err) || !flag {
+		return
+	}
+
+	switch {
+	case option.GetQuery() != "":
+		if generated, ok := v.generatedQueries[option.GetQuery()]; ok {
+			v.report(rpc, "query %s is already generated for %s", option.GetQuery(), generated)
+		} else {
+			v.claim(v.operations["query"], "query", option.GetQuery(), rpc)
+		}
+	case option.GetSubscription() != "":
+		v.claim(v.operations["subscription"], "subscription", option.GetSubscription(), rpc)
+	case option.GetMutation() != "":
+		v.claim(v.operations["mutation"], "mutation", option.GetMutation(), rpc)
+		v.claimType(rpc.Name().UpperCamelCase().String()+"Input", rpc)
+		v.claimType(rpc.Name().UpperCamelCase().String()+"Payload", rpc)
+	}
+
+	connection, err := v.m.GetConnection(rpc, option)
+	if !v.check(rpc, err) || connection == nil {
+		return
+	}
+
+	// first and after replace the paging fields of the request
+	for _, field := range rpc.Input().Fields() {
+		name := field.Name().UpperCamelCase().String()
+		if name == connection.PageSize || name == connection.PageToken {
+			continue
+		}
+		if arg := field.Name().LowerCamelCase().String(); arg == "first" || arg == "after" {
+			v.report(field, "argument %s is already used by the connection of %s", arg, rpc.FullyQualifiedName())
+		}
+	}
+}
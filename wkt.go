@@ -0,0 +1,97 @@
+package main
+
+import (
+	pgs "github.com/lyft/protoc-gen-star"
+)
+
+// wktTypes maps the well-known types converted to jaal scalars to their go package without go_package.
+var wktTypes = map[string]string{
+	".google.protobuf.Timestamp": "github.com/golang/protobuf/ptypes/timestamp",
+	".google.protobuf.Duration":  "github.com/golang/protobuf/ptypes/duration",
+	".google.protobuf.FieldMask": "google.golang.org/genproto/protobuf/field_mask",
+}
+
+type WKT struct {
+	Name    string
+	GoType  string
+	Import  string
+	Package string
+}
+
+func (m *jaalModule) WKTOf(message pgs.Message) *WKT {
+	/*
+		returns the well-known type of a message, nil if message is not converted to a jaal scalar
+		the go type follows the go_package of the well-known type, e.g. timestamp.Timestamp or timestamppb.Timestamp
+	*/
+
+	fallback, ok := wktTypes[message.FullyQualifiedName()]
+	if !ok {
+		return nil
+	}
+
+	importPath, pkg := m.GoImport(message.File(), fallback)
+
+	return &WKT{
+		Name:    message.Name().String(),
+		GoType:  pkg + "." + message.Name().String(),
+		Import:  importPath,
+		Package: pkg,
+	}
+}
+
+func (m *jaalModule) FieldWKT(field pgs.Field) *WKT {
+	// returns the well-known type of a singular or repeated field, nil if field is not a well-known type
+
+	if field.Type().IsMap() {
+		return nil
+	}
+
+	if field.Type().IsRepeated() {
+		if !field.Type().Element().IsEmbed() {
+			return nil
+		}
+		return m.WKTOf(field.Type().Element().Embed())
+	}
+
+	if !field.Type().IsEmbed() {
+		return nil
+	}
+	return m.WKTOf(field.Type().Embed())
+}
+
+func (m *jaalModule) PackageWKTs(files []pgs.File) []WKT {
+	// returns the timestamp and duration types used by the fields of the files of a package, they are converted field wise
+
+	var wkts []WKT
+	added := make(map[string]bool)
+
+	for _, file := range files {
+		for _, message := range file.AllMessages() {
+			for _, field := range message.Fields() {
+				wkt := m.FieldWKT(field)
+				if value := m.mapValueMessage(field); value != nil {
+					// map values are converted by map entries
+					wkt = m.WKTOf(value)
+				}
+				if wkt == nil || wkt.Name == "FieldMask" || added[wkt.Name] {
+					continue
+				}
+
+				added[wkt.Name] = true
+				wkts = append(wkts, *wkt)
+			}
+		}
+	}
+
+	return wkts
+}
+
+func (m *jaalModule) wktFuncs(field pgs.Field, wkt *WKT) (string, string, string) {
+	// returns the graphql side go type, to proto and from proto helpers of a singular or repeated timestamp or duration field
+
+	if field.Type().IsRepeated() {
+		return "[]*schemabuilder." + wkt.Name, "toProto" + wkt.Name + "s", "fromProto" + wkt.Name + "s"
+	}
+
+	return "*schemabuilder." + wkt.Name, "toProto" + wkt.Name, "fromProto" + wkt.Name
+}
@@ -0,0 +1,120 @@
+package main
+
+import (
+	pgs "github.com/lyft/protoc-gen-star"
+)
+
+// wrapperTypes maps the google.protobuf wrapper types to the go type of their value.
+var wrapperTypes = map[string]string{
+	".google.protobuf.DoubleValue": "float64",
+	".google.protobuf.FloatValue":  "float32",
+	".google.protobuf.Int64Value":  "int64",
+	".google.protobuf.UInt64Value": "uint64",
+	".google.protobuf.Int32Value":  "int32",
+	".google.protobuf.UInt32Value": "uint32",
+	".google.protobuf.BoolValue":   "bool",
+	".google.protobuf.StringValue": "string",
+	".google.protobuf.BytesValue":  "[]byte",
+}
+
+type Wrapper struct {
+	Name      string
+	GoType    string
+	ValueType string
+	Type      string
+	Package   string
+	Import    string
+}
+
+func (m *jaalModule) WrapperOf(message pgs.Message) *Wrapper {
+	// returns the wrapper type of a message, nil if message is not a google.protobuf wrapper
+
+	valueType, ok := wrapperTypes[message.FullyQualifiedName()]
+	if !ok {
+		return nil
+	}
+
+	importPath, pkg := m.GoImport(message.File(), "github.com/golang/protobuf/ptypes/wrappers")
+
+	// bytes are exchanged as schemabuilder.Bytes, like bytes fields
+	typ := valueType
+	if typ == "[]byte" {
+		typ = "schemabuilder.Bytes"
+	}
+
+	return &Wrapper{
+		Name:      message.Name().String(),
+		GoType:    pkg + "." + message.Name().String(),
+		ValueType: valueType,
+		Type:      typ,
+		Package:   pkg,
+		Import:    importPath,
+	}
+}
+
+func (m *jaalModule) FieldWrapper(field pgs.Field) *Wrapper {
+	// returns the wrapper type of a singular or repeated field, nil if field is not a wrapper
+
+	if field.Type().IsMap() {
+		return nil
+	}
+
+	if field.Type().IsRepeated() {
+		if !field.Type().Element().IsEmbed() {
+			return nil
+		}
+		return m.WrapperOf(field.Type().Element().Embed())
+	}
+
+	if !field.Type().IsEmbed() {
+		return nil
+	}
+	return m.WrapperOf(field.Type().Embed())
+}
+
+func (m *jaalModule) MapWrapper(field pgs.Field) *Wrapper {
+	// returns the wrapper type of the values of a map field, nil if the values are not wrappers
+
+	if !field.Type().IsMap() || !field.Type().Element().IsEmbed() {
+		return nil
+	}
+
+	return m.WrapperOf(field.Type().Element().Embed())
+}
+
+func (m *jaalModule) wrapperFuncs(field pgs.Field, wrapper *Wrapper) (string, string, string) {
+	// returns the graphql side go type, wrap and unwrap helpers of a singular or repeated wrapper field
+
+	if field.Type().IsRepeated() {
+		return "[]*" + wrapper.Type, "wrap" + wrapper.Name + "s", "unwrap" + wrapper.Name + "s"
+	}
+
+	return "*" + wrapper.Type, "wrap" + wrapper.Name, "unwrap" + wrapper.Name
+}
+
+func (m *jaalModule) PackageWrappers(files []pgs.File) []Wrapper {
+	// returns the wrapper types used by the fields of the files of a package
+
+	var wrappers []Wrapper
+	added := make(map[string]bool)
+
+	for _, file := range files {
+		for _, message := range file.AllMessages() {
+			for _, field := range message.Fields() {
+				wrapper := m.FieldWrapper(field)
+				if wrapper == nil {
+					// map values are converted by map entries
+					wrapper = m.MapWrapper(field)
+				}
+				if wrapper == nil || added[wrapper.Name] {
+					continue
+				}
+
+				added[wrapper.Name] = true
+				wrappers = append(wrappers, *wrapper)
+			}
+		}
+	}
+
+	return wrappers
+}